@@ -10,6 +10,7 @@ import (
 	"fmt"
 )
 
+// Animal x
 const (
 	// AnimalCat is a Animal of type Cat.
 	AnimalCat Animal = iota
@@ -54,5 +55,9 @@ func ParseAnimal(name string) (Animal, error) {
 	if x, ok := _AnimalValue[name]; ok {
 		return x, nil
 	}
-	return Animal(0), fmt.Errorf("%s is not a valid Animal", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Animal(0), fmt.Errorf("%q is not a valid Animal", name)
 }
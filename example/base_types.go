@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --sql --binary
+
+package example
+
+// Nibble is a byte backed enum, which is unsigned.
+// ENUM(low=1, mid=8, high=15)
+type Nibble byte
+
+// Glyph is a rune backed enum, which is signed.
+// ENUM(unset=-1, dash=45, star=42)
+type Glyph rune
@@ -0,0 +1,336 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Glyph is a rune backed enum, which is signed.
+const (
+	// GlyphUnset is a Glyph of type Unset.
+	GlyphUnset Glyph = iota + -1
+	// GlyphDash is a Glyph of type Dash.
+	GlyphDash Glyph = iota + 44
+	// GlyphStar is a Glyph of type Star.
+	GlyphStar Glyph = iota + 40
+)
+
+const _GlyphName = "unsetdashstar"
+
+var _GlyphMap = map[Glyph]string{
+	GlyphUnset: _GlyphName[0:5],
+	GlyphDash:  _GlyphName[5:9],
+	GlyphStar:  _GlyphName[9:13],
+}
+
+// String implements the Stringer interface.
+func (x Glyph) String() string {
+	if str, ok := _GlyphMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Glyph(%d)", x)
+}
+
+var _GlyphValue = map[string]Glyph{
+	_GlyphName[0:5]:  GlyphUnset,
+	_GlyphName[5:9]:  GlyphDash,
+	_GlyphName[9:13]: GlyphStar,
+}
+
+// ParseGlyph attempts to convert a string to a Glyph.
+func ParseGlyph(name string) (Glyph, error) {
+	if x, ok := _GlyphValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Glyph(0), fmt.Errorf("%q is not a valid Glyph", name)
+}
+
+// MarshalBinary implements the binary marshaller method, encoding the Glyph as its 4 byte little-endian value.
+func (x Glyph) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 4)
+	binary.LittleEndian.PutUint32(data, uint32(x))
+	return data, nil
+}
+
+// UnmarshalBinary implements the binary unmarshaller method.
+// An error is returned if data does not hold a defined Glyph.
+func (x *Glyph) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return fmt.Errorf("cannot unmarshal %d bytes into Glyph, expected 4", len(data))
+	}
+	tmp := Glyph(int32(binary.LittleEndian.Uint32(data)))
+	if _, ok := _GlyphMap[tmp]; !ok {
+		return fmt.Errorf("%d is not a valid Glyph", tmp)
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalText implements the text marshaller method.
+func (x Glyph) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Glyph) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseGlyph(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var _GlyphErrNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Glyph) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Glyph(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = Glyph(v)
+	case string:
+		*x, err = ParseGlyph(v)
+	case []byte:
+		*x, err = ParseGlyph(string(v))
+	case Glyph:
+		*x = v
+	case int:
+		*x = Glyph(v)
+	case *Glyph:
+		if v == nil {
+			return _GlyphErrNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = Glyph(v)
+	case uint64:
+		*x = Glyph(v)
+	case *int:
+		if v == nil {
+			return _GlyphErrNilPtr
+		}
+		*x = Glyph(*v)
+	case *int64:
+		if v == nil {
+			return _GlyphErrNilPtr
+		}
+		*x = Glyph(*v)
+	case json.Number:
+		var val int64
+		val, err = strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid Glyph: %w", v, err)
+		}
+		*x = Glyph(val)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = Glyph(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return _GlyphErrNilPtr
+		}
+		*x = Glyph(*v)
+	case *uint:
+		if v == nil {
+			return _GlyphErrNilPtr
+		}
+		*x = Glyph(*v)
+	case *uint64:
+		if v == nil {
+			return _GlyphErrNilPtr
+		}
+		*x = Glyph(*v)
+	case *string:
+		if v == nil {
+			return _GlyphErrNilPtr
+		}
+		*x, err = ParseGlyph(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Glyph) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// Nibble is a byte backed enum, which is unsigned.
+const (
+	// NibbleLow is a Nibble of type Low.
+	NibbleLow Nibble = iota + 1
+	// NibbleMid is a Nibble of type Mid.
+	NibbleMid Nibble = iota + 7
+	// NibbleHigh is a Nibble of type High.
+	NibbleHigh Nibble = iota + 13
+)
+
+const _NibbleName = "lowmidhigh"
+
+var _NibbleMap = map[Nibble]string{
+	NibbleLow:  _NibbleName[0:3],
+	NibbleMid:  _NibbleName[3:6],
+	NibbleHigh: _NibbleName[6:10],
+}
+
+// String implements the Stringer interface.
+func (x Nibble) String() string {
+	if str, ok := _NibbleMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Nibble(%d)", x)
+}
+
+var _NibbleValue = map[string]Nibble{
+	_NibbleName[0:3]:  NibbleLow,
+	_NibbleName[3:6]:  NibbleMid,
+	_NibbleName[6:10]: NibbleHigh,
+}
+
+// ParseNibble attempts to convert a string to a Nibble.
+func ParseNibble(name string) (Nibble, error) {
+	if x, ok := _NibbleValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Nibble(0), fmt.Errorf("%q is not a valid Nibble", name)
+}
+
+// MarshalBinary implements the binary marshaller method, encoding the Nibble as its 1 byte little-endian value.
+func (x Nibble) MarshalBinary() ([]byte, error) {
+	return []byte{byte(x)}, nil
+}
+
+// UnmarshalBinary implements the binary unmarshaller method.
+// An error is returned if data does not hold a defined Nibble.
+func (x *Nibble) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return fmt.Errorf("cannot unmarshal %d bytes into Nibble, expected 1", len(data))
+	}
+	tmp := Nibble(data[0])
+	if _, ok := _NibbleMap[tmp]; !ok {
+		return fmt.Errorf("%d is not a valid Nibble", tmp)
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalText implements the text marshaller method.
+func (x Nibble) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Nibble) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseNibble(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var _NibbleErrNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Nibble) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Nibble(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = Nibble(v)
+	case string:
+		*x, err = ParseNibble(v)
+	case []byte:
+		*x, err = ParseNibble(string(v))
+	case Nibble:
+		*x = v
+	case int:
+		*x = Nibble(v)
+	case *Nibble:
+		if v == nil {
+			return _NibbleErrNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = Nibble(v)
+	case uint64:
+		*x = Nibble(v)
+	case *int:
+		if v == nil {
+			return _NibbleErrNilPtr
+		}
+		*x = Nibble(*v)
+	case *int64:
+		if v == nil {
+			return _NibbleErrNilPtr
+		}
+		*x = Nibble(*v)
+	case json.Number:
+		var val uint64
+		val, err = strconv.ParseUint(v.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid Nibble: %w", v, err)
+		}
+		*x = Nibble(val)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = Nibble(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return _NibbleErrNilPtr
+		}
+		*x = Nibble(*v)
+	case *uint:
+		if v == nil {
+			return _NibbleErrNilPtr
+		}
+		*x = Nibble(*v)
+	case *uint64:
+		if v == nil {
+			return _NibbleErrNilPtr
+		}
+		*x = Nibble(*v)
+	case *string:
+		if v == nil {
+			return _NibbleErrNilPtr
+		}
+		*x, err = ParseNibble(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Nibble) Value() (driver.Value, error) {
+	return x.String(), nil
+}
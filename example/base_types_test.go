@@ -0,0 +1,30 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestByteAndRuneEnums(t *testing.T) {
+	var n Nibble
+	require.NoError(t, n.Scan(json.Number("15")))
+	assert.Equal(t, NibbleHigh, n)
+	assert.Error(t, n.Scan(json.Number("-1")), "a byte can not be negative")
+
+	data, err := NibbleMid.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{8}, data)
+
+	var g Glyph
+	require.NoError(t, g.Scan(json.Number("-1")))
+	assert.Equal(t, GlyphUnset, g)
+
+	data, err = GlyphUnset.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0xff, 0xff, 0xff}, data)
+	require.NoError(t, g.UnmarshalBinary([]byte{'*', 0, 0, 0}))
+	assert.Equal(t, GlyphStar, g)
+}
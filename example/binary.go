@@ -0,0 +1,15 @@
+//go:generate ../bin/go-enum -f=$GOFILE --binary
+
+package example
+
+// CacheTier is where a cached entry lives, stored in redis as its binary encoding.
+// ENUM(evicted=-1, memory, disk)
+type CacheTier int8
+
+// MediaCodec is the codec of a cached media segment.
+// ENUM(h264=264, h265=265, av1=1000)
+type MediaCodec uint16
+
+// Quota is the quota plan of a cached account.
+// ENUM(free, pro=1000000, unlimited=-1)
+type Quota int
@@ -0,0 +1,205 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// CacheTier is where a cached entry lives, stored in redis as its binary encoding.
+const (
+	// CacheTierEvicted is a CacheTier of type Evicted.
+	CacheTierEvicted CacheTier = iota + -1
+	// CacheTierMemory is a CacheTier of type Memory.
+	CacheTierMemory
+	// CacheTierDisk is a CacheTier of type Disk.
+	CacheTierDisk
+)
+
+const _CacheTierName = "evictedmemorydisk"
+
+var _CacheTierMap = map[CacheTier]string{
+	CacheTierEvicted: _CacheTierName[0:7],
+	CacheTierMemory:  _CacheTierName[7:13],
+	CacheTierDisk:    _CacheTierName[13:17],
+}
+
+// String implements the Stringer interface.
+func (x CacheTier) String() string {
+	if str, ok := _CacheTierMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("CacheTier(%d)", x)
+}
+
+var _CacheTierValue = map[string]CacheTier{
+	_CacheTierName[0:7]:   CacheTierEvicted,
+	_CacheTierName[7:13]:  CacheTierMemory,
+	_CacheTierName[13:17]: CacheTierDisk,
+}
+
+// ParseCacheTier attempts to convert a string to a CacheTier.
+func ParseCacheTier(name string) (CacheTier, error) {
+	if x, ok := _CacheTierValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return CacheTier(0), fmt.Errorf("%q is not a valid CacheTier", name)
+}
+
+// MarshalBinary implements the binary marshaller method, encoding the CacheTier as its 1 byte little-endian value.
+func (x CacheTier) MarshalBinary() ([]byte, error) {
+	return []byte{byte(x)}, nil
+}
+
+// UnmarshalBinary implements the binary unmarshaller method.
+// An error is returned if data does not hold a defined CacheTier.
+func (x *CacheTier) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return fmt.Errorf("cannot unmarshal %d bytes into CacheTier, expected 1", len(data))
+	}
+	tmp := CacheTier(int8(data[0]))
+	if _, ok := _CacheTierMap[tmp]; !ok {
+		return fmt.Errorf("%d is not a valid CacheTier", tmp)
+	}
+	*x = tmp
+	return nil
+}
+
+// MediaCodec is the codec of a cached media segment.
+const (
+	// MediaCodecH264 is a MediaCodec of type H264.
+	MediaCodecH264 MediaCodec = iota + 264
+	// MediaCodecH265 is a MediaCodec of type H265.
+	MediaCodecH265
+	// MediaCodecAv1 is a MediaCodec of type Av1.
+	MediaCodecAv1 MediaCodec = iota + 998
+)
+
+const _MediaCodecName = "h264h265av1"
+
+var _MediaCodecMap = map[MediaCodec]string{
+	MediaCodecH264: _MediaCodecName[0:4],
+	MediaCodecH265: _MediaCodecName[4:8],
+	MediaCodecAv1:  _MediaCodecName[8:11],
+}
+
+// String implements the Stringer interface.
+func (x MediaCodec) String() string {
+	if str, ok := _MediaCodecMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("MediaCodec(%d)", x)
+}
+
+var _MediaCodecValue = map[string]MediaCodec{
+	_MediaCodecName[0:4]:  MediaCodecH264,
+	_MediaCodecName[4:8]:  MediaCodecH265,
+	_MediaCodecName[8:11]: MediaCodecAv1,
+}
+
+// ParseMediaCodec attempts to convert a string to a MediaCodec.
+func ParseMediaCodec(name string) (MediaCodec, error) {
+	if x, ok := _MediaCodecValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return MediaCodec(0), fmt.Errorf("%q is not a valid MediaCodec", name)
+}
+
+// MarshalBinary implements the binary marshaller method, encoding the MediaCodec as its 2 byte little-endian value.
+func (x MediaCodec) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 2)
+	binary.LittleEndian.PutUint16(data, uint16(x))
+	return data, nil
+}
+
+// UnmarshalBinary implements the binary unmarshaller method.
+// An error is returned if data does not hold a defined MediaCodec.
+func (x *MediaCodec) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return fmt.Errorf("cannot unmarshal %d bytes into MediaCodec, expected 2", len(data))
+	}
+	tmp := MediaCodec(binary.LittleEndian.Uint16(data))
+	if _, ok := _MediaCodecMap[tmp]; !ok {
+		return fmt.Errorf("%d is not a valid MediaCodec", tmp)
+	}
+	*x = tmp
+	return nil
+}
+
+// Quota is the quota plan of a cached account.
+const (
+	// QuotaFree is a Quota of type Free.
+	QuotaFree Quota = iota
+	// QuotaPro is a Quota of type Pro.
+	QuotaPro Quota = iota + 999999
+	// QuotaUnlimited is a Quota of type Unlimited.
+	QuotaUnlimited Quota = iota + -3
+)
+
+const _QuotaName = "freeprounlimited"
+
+var _QuotaMap = map[Quota]string{
+	QuotaFree:      _QuotaName[0:4],
+	QuotaPro:       _QuotaName[4:7],
+	QuotaUnlimited: _QuotaName[7:16],
+}
+
+// String implements the Stringer interface.
+func (x Quota) String() string {
+	if str, ok := _QuotaMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Quota(%d)", x)
+}
+
+var _QuotaValue = map[string]Quota{
+	_QuotaName[0:4]:  QuotaFree,
+	_QuotaName[4:7]:  QuotaPro,
+	_QuotaName[7:16]: QuotaUnlimited,
+}
+
+// ParseQuota attempts to convert a string to a Quota.
+func ParseQuota(name string) (Quota, error) {
+	if x, ok := _QuotaValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Quota(0), fmt.Errorf("%q is not a valid Quota", name)
+}
+
+// MarshalBinary implements the binary marshaller method, encoding the Quota as its 8 byte little-endian value.
+func (x Quota) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, uint64(x))
+	return data, nil
+}
+
+// UnmarshalBinary implements the binary unmarshaller method.
+// An error is returned if data does not hold a defined Quota.
+func (x *Quota) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("cannot unmarshal %d bytes into Quota, expected 8", len(data))
+	}
+	tmp := Quota(int64(binary.LittleEndian.Uint64(data)))
+	if _, ok := _QuotaMap[tmp]; !ok {
+		return fmt.Errorf("%d is not a valid Quota", tmp)
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,59 @@
+package example
+
+import (
+	"encoding"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	tests := map[string]struct {
+		value    encoding.BinaryMarshaler
+		decoded  encoding.BinaryUnmarshaler
+		expected []byte
+	}{
+		"int8 negative":   {value: CacheTierEvicted, decoded: new(CacheTier), expected: []byte{0xff}},
+		"int8":            {value: CacheTierDisk, decoded: new(CacheTier), expected: []byte{0x01}},
+		"uint16":          {value: MediaCodecAv1, decoded: new(MediaCodec), expected: []byte{0xe8, 0x03}},
+		"int":             {value: QuotaPro, decoded: new(Quota), expected: []byte{0x40, 0x42, 0x0f, 0, 0, 0, 0, 0}},
+		"int negative":    {value: QuotaUnlimited, decoded: new(Quota), expected: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		"int zero":        {value: QuotaFree, decoded: new(Quota), expected: make([]byte, 8)},
+		"uint16 low byte": {value: MediaCodecH264, decoded: new(MediaCodec), expected: []byte{0x08, 0x01}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := tc.value.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, data)
+
+			require.NoError(t, tc.decoded.UnmarshalBinary(data))
+			assert.Equal(t, tc.value, binaryElem(tc.decoded))
+		})
+	}
+}
+
+func binaryElem(x encoding.BinaryUnmarshaler) encoding.BinaryMarshaler {
+	switch x := x.(type) {
+	case *CacheTier:
+		return *x
+	case *MediaCodec:
+		return *x
+	case *Quota:
+		return *x
+	}
+	return nil
+}
+
+func TestBinaryUnmarshalInvalid(t *testing.T) {
+	var tier CacheTier
+	assert.EqualError(t, tier.UnmarshalBinary([]byte{0x05}), "5 is not a valid CacheTier")
+	assert.EqualError(t, tier.UnmarshalBinary([]byte{0x00, 0x00}), "cannot unmarshal 2 bytes into CacheTier, expected 1")
+	assert.Equal(t, CacheTier(0), tier, "a failed unmarshal leaves the value untouched")
+
+	var codec MediaCodec
+	assert.EqualError(t, codec.UnmarshalBinary([]byte{0x0a, 0x01}), "266 is not a valid MediaCodec")
+	assert.EqualError(t, codec.UnmarshalBinary(nil), "cannot unmarshal 0 bytes into MediaCodec, expected 2")
+}
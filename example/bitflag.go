@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --bitflag --marshal
+
+package example
+
+// Access is a set of file access rights, which can be combined.
+// ENUM(none=0, read, write, execute, all=7, admin=64, audit)
+type Access uint8
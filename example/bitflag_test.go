@@ -0,0 +1,96 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessBitflag(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		assert.Equal(t, Access(0), AccessNone)
+		assert.Equal(t, Access(1), AccessRead)
+		assert.Equal(t, Access(2), AccessWrite)
+		assert.Equal(t, Access(4), AccessExecute)
+		assert.Equal(t, Access(7), AccessAll, "explicit values are kept")
+		assert.Equal(t, Access(64), AccessAdmin)
+		assert.Equal(t, Access(128), AccessAudit, "values after an explicit one use the next free bit")
+	})
+
+	t.Run("has set clear", func(t *testing.T) {
+		x := AccessNone.Set(AccessRead).Set(AccessExecute)
+		assert.True(t, x.Has(AccessRead))
+		assert.True(t, x.Has(AccessExecute))
+		assert.False(t, x.Has(AccessWrite))
+		assert.False(t, x.Has(AccessAll))
+		assert.True(t, x.Set(AccessWrite).Has(AccessAll))
+
+		x = x.Clear(AccessRead)
+		assert.False(t, x.Has(AccessRead))
+		assert.Equal(t, AccessExecute, x)
+		assert.Equal(t, AccessNone, AccessAll.Clear(AccessAll))
+	})
+
+	t.Run("set of and each", func(t *testing.T) {
+		x := AccessSetOf(AccessAudit, AccessRead, AccessWrite)
+		assert.Equal(t, AccessRead|AccessWrite|AccessAudit, x)
+
+		var flags []Access
+		x.Each(func(flag Access) {
+			flags = append(flags, flag)
+		})
+		assert.Equal(t, []Access{AccessRead, AccessWrite, AccessAudit}, flags, "flags are visited in declaration order")
+
+		empty := AccessSetOf()
+		assert.Equal(t, AccessNone, empty)
+		empty.Each(func(flag Access) {
+			t.Errorf("the empty set has no flags, got %v", flag)
+		})
+	})
+
+	t.Run("string", func(t *testing.T) {
+		tests := map[Access]string{
+			AccessNone:                 "none",
+			AccessWrite:                "write",
+			AccessRead | AccessExecute: "read|execute",
+			AccessAll:                  "all",
+			AccessAll | AccessAudit:    "read|write|execute|audit",
+			AccessAdmin | AccessRead:   "read|admin",
+			Access(8):                  "Access(8)",
+			AccessRead | Access(16):    "Access(17)",
+		}
+		for x, expected := range tests {
+			assert.Equal(t, expected, x.String())
+		}
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		tests := map[string]Access{
+			"read":                   AccessRead,
+			"read|write":             AccessRead | AccessWrite,
+			"execute | admin | read": AccessRead | AccessExecute | AccessAdmin,
+			"all|audit":              AccessAll | AccessAudit,
+		}
+		for input, expected := range tests {
+			x, err := ParseAccess(input)
+			require.NoError(t, err, input)
+			assert.Equal(t, expected, x, input)
+		}
+
+		_, err := ParseAccess("read|delete")
+		assert.EqualError(t, err, "\"delete\" is not a valid Access")
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		in := []Access{AccessNone, AccessRead | AccessWrite, AccessAdmin | AccessAudit | AccessExecute}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `["none","read|write","execute|admin|audit"]`, string(b))
+
+		var out []Access
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --bson
+
+package example
+
+// Membership is an enumeration of membership levels, as stored in MongoDB documents.
+// ENUM(guest, member, admin)
+type Membership int
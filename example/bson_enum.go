@@ -0,0 +1,77 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// Membership is an enumeration of membership levels, as stored in MongoDB documents.
+const (
+	// MembershipGuest is a Membership of type Guest.
+	MembershipGuest Membership = iota
+	// MembershipMember is a Membership of type Member.
+	MembershipMember
+	// MembershipAdmin is a Membership of type Admin.
+	MembershipAdmin
+)
+
+const _MembershipName = "guestmemberadmin"
+
+var _MembershipMap = map[Membership]string{
+	MembershipGuest:  _MembershipName[0:5],
+	MembershipMember: _MembershipName[5:11],
+	MembershipAdmin:  _MembershipName[11:16],
+}
+
+// String implements the Stringer interface.
+func (x Membership) String() string {
+	if str, ok := _MembershipMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Membership(%d)", x)
+}
+
+var _MembershipValue = map[string]Membership{
+	_MembershipName[0:5]:   MembershipGuest,
+	_MembershipName[5:11]:  MembershipMember,
+	_MembershipName[11:16]: MembershipAdmin,
+}
+
+// ParseMembership attempts to convert a string to a Membership.
+func ParseMembership(name string) (Membership, error) {
+	if x, ok := _MembershipValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Membership(0), fmt.Errorf("%q is not a valid Membership", name)
+}
+
+// MarshalBSONValue implements the bson value marshaller method, storing the Membership as a string.
+func (x Membership) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(x.String())
+}
+
+// UnmarshalBSONValue implements the bson value unmarshaller method.
+func (x *Membership) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	name, ok := bson.RawValue{Type: t, Value: data}.StringValueOK()
+	if !ok {
+		return fmt.Errorf("cannot unmarshal bson %s into Membership, expected a string", t)
+	}
+	tmp, err := ParseMembership(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,31 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type bsonAccount struct {
+	Level Membership `bson:"level"`
+}
+
+func TestMembershipBSON(t *testing.T) {
+	b, err := bson.Marshal(bsonAccount{Level: MembershipAdmin})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", bson.Raw(b).Lookup("level").StringValue())
+
+	var account bsonAccount
+	require.NoError(t, bson.Unmarshal(b, &account))
+	assert.Equal(t, MembershipAdmin, account.Level)
+
+	b, err = bson.Marshal(bson.M{"level": "owner"})
+	require.NoError(t, err)
+	assert.EqualError(t, bson.Unmarshal(b, &account), "error decoding key level: \"owner\" is not a valid Membership")
+
+	b, err = bson.Marshal(bson.M{"level": 2})
+	require.NoError(t, err)
+	assert.EqualError(t, bson.Unmarshal(b, &account), "error decoding key level: cannot unmarshal bson 32-bit integer into Membership, expected a string")
+}
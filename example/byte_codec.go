@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --bytecodec
+
+package example
+
+// Opcode is an instruction of the wire protocol, whose values are spread out to leave room for related instructions.
+// ENUM(noop, read=10, write=20, _, flush=40, close=100)
+type Opcode uint16
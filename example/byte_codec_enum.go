@@ -0,0 +1,109 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Opcode is an instruction of the wire protocol, whose values are spread out to leave room for related instructions.
+const (
+	// OpcodeNoop is a Opcode of type Noop.
+	OpcodeNoop Opcode = iota
+	// OpcodeRead is a Opcode of type Read.
+	OpcodeRead Opcode = iota + 9
+	// OpcodeWrite is a Opcode of type Write.
+	OpcodeWrite Opcode = iota + 18
+	// Skipped value.
+	_
+	// OpcodeFlush is a Opcode of type Flush.
+	OpcodeFlush Opcode = iota + 36
+	// OpcodeClose is a Opcode of type Close.
+	OpcodeClose Opcode = iota + 95
+)
+
+const _OpcodeName = "noopreadwriteflushclose"
+
+var _OpcodeMap = map[Opcode]string{
+	OpcodeNoop:  _OpcodeName[0:4],
+	OpcodeRead:  _OpcodeName[4:8],
+	OpcodeWrite: _OpcodeName[8:13],
+	OpcodeFlush: _OpcodeName[13:18],
+	OpcodeClose: _OpcodeName[18:23],
+}
+
+// String implements the Stringer interface.
+func (x Opcode) String() string {
+	if str, ok := _OpcodeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Opcode(%d)", x)
+}
+
+var _OpcodeValue = map[string]Opcode{
+	_OpcodeName[0:4]:   OpcodeNoop,
+	_OpcodeName[4:8]:   OpcodeRead,
+	_OpcodeName[8:13]:  OpcodeWrite,
+	_OpcodeName[13:18]: OpcodeFlush,
+	_OpcodeName[18:23]: OpcodeClose,
+}
+
+// ParseOpcode attempts to convert a string to a Opcode.
+func ParseOpcode(name string) (Opcode, error) {
+	if x, ok := _OpcodeValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Opcode(0), fmt.Errorf("%q is not a valid Opcode", name)
+}
+
+var _OpcodeByteValues = []Opcode{
+	OpcodeNoop,
+	OpcodeRead,
+	OpcodeWrite,
+	OpcodeFlush,
+	OpcodeClose,
+}
+
+var _OpcodeByteMap = map[Opcode]int{
+	OpcodeNoop:  0,
+	OpcodeRead:  1,
+	OpcodeWrite: 2,
+	OpcodeFlush: 3,
+	OpcodeClose: 4,
+}
+
+// Byte returns the single byte encoding of the Opcode, which is its declaration order index.
+// It panics if the Opcode is not a defined value, use ToByte to get an error instead.
+func (x Opcode) Byte() byte {
+	b, err := x.ToByte()
+	if err != nil {
+		panic(fmt.Sprintf("%v, use ToByte to handle undefined values", err))
+	}
+	return b
+}
+
+// ToByte returns the single byte encoding of the Opcode, which is its declaration order index,
+// or an error if the Opcode is not a defined value, as there is no byte to encode it with.
+func (x Opcode) ToByte() (byte, error) {
+	i, ok := _OpcodeByteMap[x]
+	if !ok {
+		return 0, fmt.Errorf("%v is not a defined Opcode and has no byte encoding", x)
+	}
+	return byte(i), nil
+}
+
+// OpcodeFromByte returns the Opcode encoded by the single byte b, as produced by Opcode.Byte.
+func OpcodeFromByte(b byte) (Opcode, error) {
+	if int(b) >= len(_OpcodeByteValues) {
+		return Opcode(0), fmt.Errorf("%d is not a valid byte encoding of Opcode", b)
+	}
+	return _OpcodeByteValues[b], nil
+}
@@ -0,0 +1,32 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpcodeByteCodec(t *testing.T) {
+	for i, x := range []Opcode{OpcodeNoop, OpcodeRead, OpcodeWrite, OpcodeFlush, OpcodeClose} {
+		b := x.Byte()
+		assert.Equal(t, byte(i), b)
+
+		decoded, err := OpcodeFromByte(b)
+		require.NoError(t, err)
+		assert.Equal(t, x, decoded)
+	}
+
+	assert.Equal(t, byte(4), OpcodeClose.Byte())
+
+	_, err := OpcodeFromByte(5)
+	assert.EqualError(t, err, "5 is not a valid byte encoding of Opcode")
+
+	b, err := OpcodeWrite.ToByte()
+	require.NoError(t, err)
+	assert.Equal(t, byte(2), b)
+
+	_, err = Opcode(11).ToByte()
+	assert.EqualError(t, err, "Opcode(11) is not a defined Opcode and has no byte encoding")
+	assert.PanicsWithValue(t, "Opcode(11) is not a defined Opcode and has no byte encoding, use ToByte to handle undefined values", func() { Opcode(11).Byte() })
+}
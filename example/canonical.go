@@ -0,0 +1,16 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal
+
+package example
+
+// Currency is an enumeration of currencies, where some have more than one name.
+/*
+ENUM(
+usd
+dollar=0 // canonical The name shown for the value
+buck=0
+eur // canonical
+euro=1
+gbp
+)
+*/
+type Currency int
@@ -0,0 +1,30 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyCanonical(t *testing.T) {
+	assert.Equal(t, CurrencyUsd, CurrencyDollar)
+	assert.Equal(t, CurrencyUsd, CurrencyBuck)
+
+	// The name marked canonical is used, even though it isn't the first one declared.
+	assert.Equal(t, "dollar", CurrencyUsd.String())
+	// Without a canonical marker, the first name declared is used.
+	assert.Equal(t, "eur", CurrencyEuro.String())
+	assert.Equal(t, "gbp", CurrencyGbp.String())
+
+	// Every name still parses to the shared value.
+	for _, name := range []string{"usd", "dollar", "buck"} {
+		x, err := ParseCurrency(name)
+		require.NoError(t, err)
+		assert.Equal(t, CurrencyUsd, x)
+	}
+
+	b, err := CurrencyBuck.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "dollar", string(b))
+}
@@ -0,0 +1,16 @@
+//go:generate ../bin/go-enum -f=$GOFILE --categories
+
+package example
+
+// OrderStatus is an enumeration of order states, grouped by how they can be handled.
+/*
+ENUM(
+new // category=active
+processing // category=active,cancellable
+on_hold // Waiting for payment category=active,cancellable
+delivered // category=terminal
+refunded // category=terminal,financial
+archived
+)
+*/
+type OrderStatus int
@@ -0,0 +1,150 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// OrderStatus is an enumeration of order states, grouped by how they can be handled.
+const (
+	// OrderStatusNew is a OrderStatus of type New.
+	// category=active
+	OrderStatusNew OrderStatus = iota
+	// OrderStatusProcessing is a OrderStatus of type Processing.
+	// category=active,cancellable
+	OrderStatusProcessing
+	// OrderStatusOnHold is a OrderStatus of type On_hold.
+	// Waiting for payment category=active,cancellable
+	OrderStatusOnHold
+	// OrderStatusDelivered is a OrderStatus of type Delivered.
+	// category=terminal
+	OrderStatusDelivered
+	// OrderStatusRefunded is a OrderStatus of type Refunded.
+	// category=terminal,financial
+	OrderStatusRefunded
+	// OrderStatusArchived is a OrderStatus of type Archived.
+	OrderStatusArchived
+)
+
+const _OrderStatusName = "newprocessingon_holddeliveredrefundedarchived"
+
+var _OrderStatusMap = map[OrderStatus]string{
+	OrderStatusNew:        _OrderStatusName[0:3],
+	OrderStatusProcessing: _OrderStatusName[3:13],
+	OrderStatusOnHold:     _OrderStatusName[13:20],
+	OrderStatusDelivered:  _OrderStatusName[20:29],
+	OrderStatusRefunded:   _OrderStatusName[29:37],
+	OrderStatusArchived:   _OrderStatusName[37:45],
+}
+
+// String implements the Stringer interface.
+func (x OrderStatus) String() string {
+	if str, ok := _OrderStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("OrderStatus(%d)", x)
+}
+
+var _OrderStatusValue = map[string]OrderStatus{
+	_OrderStatusName[0:3]:   OrderStatusNew,
+	_OrderStatusName[3:13]:  OrderStatusProcessing,
+	_OrderStatusName[13:20]: OrderStatusOnHold,
+	_OrderStatusName[20:29]: OrderStatusDelivered,
+	_OrderStatusName[29:37]: OrderStatusRefunded,
+	_OrderStatusName[37:45]: OrderStatusArchived,
+}
+
+// ParseOrderStatus attempts to convert a string to a OrderStatus.
+func ParseOrderStatus(name string) (OrderStatus, error) {
+	if x, ok := _OrderStatusValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return OrderStatus(0), fmt.Errorf("%q is not a valid OrderStatus", name)
+}
+
+var _OrderStatusCategories = map[OrderStatus]map[string]bool{
+	OrderStatusNew:        {"active": true},
+	OrderStatusProcessing: {"active": true, "cancellable": true},
+	OrderStatusOnHold:     {"active": true, "cancellable": true},
+	OrderStatusDelivered:  {"terminal": true},
+	OrderStatusRefunded:   {"terminal": true, "financial": true},
+}
+
+// InCategory reports whether the OrderStatus belongs to the named category.
+func (x OrderStatus) InCategory(category string) bool {
+	return _OrderStatusCategories[x][category]
+}
+
+// OrderStatusSet is a list of OrderStatus values, such as the members of a category.
+type OrderStatusSet []OrderStatus
+
+// Contains reports whether x is in the set.
+func (s OrderStatusSet) Contains(x OrderStatus) bool {
+	for _, v := range s {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+var _OrderStatusActiveSet = OrderStatusSet{
+	OrderStatusNew,
+	OrderStatusProcessing,
+	OrderStatusOnHold,
+}
+
+// OrderStatusActiveSet returns the OrderStatus values in the "active" category, in declaration order.
+// Every call returns a copy that is safe to modify.
+func OrderStatusActiveSet() OrderStatusSet {
+	tmp := make(OrderStatusSet, len(_OrderStatusActiveSet))
+	copy(tmp, _OrderStatusActiveSet)
+	return tmp
+}
+
+var _OrderStatusCancellableSet = OrderStatusSet{
+	OrderStatusProcessing,
+	OrderStatusOnHold,
+}
+
+// OrderStatusCancellableSet returns the OrderStatus values in the "cancellable" category, in declaration order.
+// Every call returns a copy that is safe to modify.
+func OrderStatusCancellableSet() OrderStatusSet {
+	tmp := make(OrderStatusSet, len(_OrderStatusCancellableSet))
+	copy(tmp, _OrderStatusCancellableSet)
+	return tmp
+}
+
+var _OrderStatusTerminalSet = OrderStatusSet{
+	OrderStatusDelivered,
+	OrderStatusRefunded,
+}
+
+// OrderStatusTerminalSet returns the OrderStatus values in the "terminal" category, in declaration order.
+// Every call returns a copy that is safe to modify.
+func OrderStatusTerminalSet() OrderStatusSet {
+	tmp := make(OrderStatusSet, len(_OrderStatusTerminalSet))
+	copy(tmp, _OrderStatusTerminalSet)
+	return tmp
+}
+
+var _OrderStatusFinancialSet = OrderStatusSet{
+	OrderStatusRefunded,
+}
+
+// OrderStatusFinancialSet returns the OrderStatus values in the "financial" category, in declaration order.
+// Every call returns a copy that is safe to modify.
+func OrderStatusFinancialSet() OrderStatusSet {
+	tmp := make(OrderStatusSet, len(_OrderStatusFinancialSet))
+	copy(tmp, _OrderStatusFinancialSet)
+	return tmp
+}
@@ -0,0 +1,72 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderStatusInCategory(t *testing.T) {
+	tests := map[OrderStatus][]string{
+		OrderStatusNew:        {"active"},
+		OrderStatusProcessing: {"active", "cancellable"},
+		OrderStatusOnHold:     {"active", "cancellable"},
+		OrderStatusDelivered:  {"terminal"},
+		OrderStatusRefunded:   {"terminal", "financial"},
+		OrderStatusArchived:   nil,
+		OrderStatus(99):       nil,
+	}
+	categories := []string{"active", "cancellable", "terminal", "financial", "unknown"}
+
+	for x, expected := range tests {
+		for _, category := range categories {
+			assert.Equal(t, containsCategory(expected, category), x.InCategory(category), "%s in %s", x, category)
+		}
+	}
+}
+
+func containsCategory(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestOrderStatusCategorySets(t *testing.T) {
+	tests := map[string]struct {
+		set      OrderStatusSet
+		expected OrderStatusSet
+	}{
+		"active": {
+			set:      OrderStatusActiveSet(),
+			expected: OrderStatusSet{OrderStatusNew, OrderStatusProcessing, OrderStatusOnHold},
+		},
+		"cancellable": {
+			set:      OrderStatusCancellableSet(),
+			expected: OrderStatusSet{OrderStatusProcessing, OrderStatusOnHold},
+		},
+		"terminal": {
+			set:      OrderStatusTerminalSet(),
+			expected: OrderStatusSet{OrderStatusDelivered, OrderStatusRefunded},
+		},
+		"financial": {
+			set:      OrderStatusFinancialSet(),
+			expected: OrderStatusSet{OrderStatusRefunded},
+		},
+	}
+
+	for category, tc := range tests {
+		t.Run(category, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.set)
+			for _, x := range []OrderStatus{OrderStatusNew, OrderStatusProcessing, OrderStatusOnHold, OrderStatusDelivered, OrderStatusRefunded, OrderStatusArchived} {
+				assert.Equal(t, x.InCategory(category), tc.set.Contains(x), "%s in %s", x, category)
+			}
+		})
+	}
+
+	set := OrderStatusTerminalSet()
+	set[0] = OrderStatusNew
+	assert.Equal(t, OrderStatusDelivered, OrderStatusTerminalSet()[0], "callers must not be able to change the shared set")
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 )
 
+// Color is an enumeration of colors that are allowed.
 const (
 	// ColorBlack is a Color of type Black.
 	ColorBlack Color = iota
@@ -91,7 +92,11 @@ func ParseColor(name string) (Color, error) {
 	if x, ok := _ColorValue[name]; ok {
 		return x, nil
 	}
-	return Color(0), fmt.Errorf("%s is not a valid Color", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Color(0), fmt.Errorf("%q is not a valid Color", name)
 }
 
 // MustParseColor converts a string to a Color, and panics if is not valid.
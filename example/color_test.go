@@ -3,6 +3,7 @@ package example
 import (
 	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -26,7 +27,7 @@ func TestColorString(t *testing.T) {
 func TestColorMustParse(t *testing.T) {
 	x := `avocadogreen`
 
-	assert.PanicsWithError(t, x+" is not a valid Color", func() { MustParseColor(x) })
+	assert.PanicsWithError(t, strconv.Quote(x)+" is not a valid Color", func() { MustParseColor(x) })
 	assert.NotPanics(t, func() { MustParseColor(ColorGreen.String()) })
 }
 
@@ -148,7 +149,7 @@ func TestColorUnmarshal(t *testing.T) {
 			input:         `{"color":"Magenta"}`,
 			output:        &testData{ColorX: ColorYellow},
 			errorExpected: true,
-			err:           errors.New("Magenta is not a valid Color"),
+			err:           errors.New("\"Magenta\" is not a valid Color"),
 		},
 	}
 
@@ -11,6 +11,7 @@ import (
 	"strings"
 )
 
+// Commented is an enumeration of commented values
 const (
 	// CommentedValue1 is a Commented of type Value1.
 	// Commented value 1
@@ -52,7 +53,11 @@ func ParseCommented(name string) (Commented, error) {
 	if x, ok := _CommentedValue[name]; ok {
 		return x, nil
 	}
-	return Commented(0), fmt.Errorf("%s is not a valid Commented", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Commented(0), fmt.Errorf("%q is not a valid Commented", name)
 }
 
 // MarshalText implements the text marshaller method.
@@ -71,6 +76,7 @@ func (x *Commented) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// ComplexCommented has some extra complicated parsing rules.
 const (
 	// Skipped value.
 	// Placeholder with a ','  in it. (for harder testing)
@@ -115,7 +121,11 @@ func ParseComplexCommented(name string) (ComplexCommented, error) {
 	if x, ok := _ComplexCommentedValue[name]; ok {
 		return x, nil
 	}
-	return ComplexCommented(0), fmt.Errorf("%s is not a valid ComplexCommented", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ComplexCommented(0), fmt.Errorf("%q is not a valid ComplexCommented", name)
 }
 
 // MarshalText implements the text marshaller method.
@@ -62,7 +62,7 @@ func TestCommentedUnmarshal(t *testing.T) {
 			input:         `{"Commented":"value4"}`,
 			output:        nil,
 			errorExpected: true,
-			err:           errors.New("value4 is not a valid Commented"),
+			err:           errors.New("\"value4\" is not a valid Commented"),
 		},
 	}
 
@@ -137,7 +137,7 @@ func TestComplexCommentedUnmarshal(t *testing.T) {
 			input:         `{"ComplexCommented":"value4"}`,
 			output:        nil,
 			errorExpected: true,
-			err:           errors.New("value4 is not a valid ComplexCommented"),
+			err:           errors.New("\"value4\" is not a valid ComplexCommented"),
 		},
 	}
 
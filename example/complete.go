@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --complete
+
+package example
+
+// ENUM(Create, Copy, Cut, Delete, Paste)
+type EditAction int
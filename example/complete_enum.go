@@ -0,0 +1,83 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// EditActionCreate is a EditAction of type Create.
+	EditActionCreate EditAction = iota
+	// EditActionCopy is a EditAction of type Copy.
+	EditActionCopy
+	// EditActionCut is a EditAction of type Cut.
+	EditActionCut
+	// EditActionDelete is a EditAction of type Delete.
+	EditActionDelete
+	// EditActionPaste is a EditAction of type Paste.
+	EditActionPaste
+)
+
+const _EditActionName = "CreateCopyCutDeletePaste"
+
+var _EditActionMap = map[EditAction]string{
+	EditActionCreate: _EditActionName[0:6],
+	EditActionCopy:   _EditActionName[6:10],
+	EditActionCut:    _EditActionName[10:13],
+	EditActionDelete: _EditActionName[13:19],
+	EditActionPaste:  _EditActionName[19:24],
+}
+
+// String implements the Stringer interface.
+func (x EditAction) String() string {
+	if str, ok := _EditActionMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("EditAction(%d)", x)
+}
+
+var _EditActionValue = map[string]EditAction{
+	_EditActionName[0:6]:   EditActionCreate,
+	_EditActionName[6:10]:  EditActionCopy,
+	_EditActionName[10:13]: EditActionCut,
+	_EditActionName[13:19]: EditActionDelete,
+	_EditActionName[19:24]: EditActionPaste,
+}
+
+// ParseEditAction attempts to convert a string to a EditAction.
+func ParseEditAction(name string) (EditAction, error) {
+	if x, ok := _EditActionValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return EditAction(0), fmt.Errorf("%q is not a valid EditAction", name)
+}
+
+var _EditActionCompletions = []EditAction{
+	EditActionCreate,
+	EditActionCopy,
+	EditActionCut,
+	EditActionDelete,
+	EditActionPaste,
+}
+
+// EditActionComplete returns every EditAction whose name starts with the given prefix, ignoring case.
+func EditActionComplete(prefix string) []EditAction {
+	prefix = strings.ToLower(prefix)
+	var matches []EditAction
+	for _, x := range _EditActionCompletions {
+		if strings.HasPrefix(strings.ToLower(x.String()), prefix) {
+			matches = append(matches, x)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,32 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditActionComplete(t *testing.T) {
+	tests := map[string]struct {
+		prefix   string
+		expected []EditAction
+	}{
+		"several": {
+			prefix:   "c",
+			expected: []EditAction{EditActionCreate, EditActionCopy, EditActionCut},
+		},
+		"exactly one": {
+			prefix:   "DEL",
+			expected: []EditAction{EditActionDelete},
+		},
+		"none": {
+			prefix: "undo",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, EditActionComplete(tc.prefix))
+		})
+	}
+}
@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --csv --forcelower
+
+package example
+
+// ENUM(Pending, Shipped, Delivered)
+type Shipment int
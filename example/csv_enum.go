@@ -0,0 +1,72 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ShipmentPending is a Shipment of type Pending.
+	ShipmentPending Shipment = iota
+	// ShipmentShipped is a Shipment of type Shipped.
+	ShipmentShipped
+	// ShipmentDelivered is a Shipment of type Delivered.
+	ShipmentDelivered
+)
+
+const _ShipmentName = "pendingshippeddelivered"
+
+var _ShipmentMap = map[Shipment]string{
+	ShipmentPending:   _ShipmentName[0:7],
+	ShipmentShipped:   _ShipmentName[7:14],
+	ShipmentDelivered: _ShipmentName[14:23],
+}
+
+// String implements the Stringer interface.
+func (x Shipment) String() string {
+	if str, ok := _ShipmentMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Shipment(%d)", x)
+}
+
+var _ShipmentValue = map[string]Shipment{
+	_ShipmentName[0:7]:   ShipmentPending,
+	_ShipmentName[7:14]:  ShipmentShipped,
+	_ShipmentName[14:23]: ShipmentDelivered,
+}
+
+// ParseShipment attempts to convert a string to a Shipment.
+func ParseShipment(name string) (Shipment, error) {
+	if x, ok := _ShipmentValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Shipment(0), fmt.Errorf("%q is not a valid Shipment", name)
+}
+
+// CSVString returns the Shipment as a CSV field, quoting it when it contains characters that require it.
+func (x Shipment) CSVString() string {
+	str := x.String()
+	if !strings.ContainsAny(str, ",\"\r\n") {
+		return str
+	}
+	return `"` + strings.ReplaceAll(str, `"`, `""`) + `"`
+}
+
+// ParseShipmentCSV attempts to convert a CSV field, quoted or not, to a Shipment.
+func ParseShipmentCSV(field string) (Shipment, error) {
+	if len(field) >= 2 && strings.HasPrefix(field, `"`) && strings.HasSuffix(field, `"`) {
+		field = strings.ReplaceAll(field[1:len(field)-1], `""`, `"`)
+	}
+	return ParseShipment(field)
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --csvresourceheader
+
+package example
+
+// CountryCode is an enumeration of ISO 3166 numeric country codes, read from countries.csv.
+// ENUM(@csv:countries.csv)
+type CountryCode int
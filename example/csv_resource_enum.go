@@ -0,0 +1,59 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// CountryCode is an enumeration of ISO 3166 numeric country codes, read from countries.csv.
+const (
+	// CountryCodeNetherlands is a CountryCode of type Netherlands.
+	CountryCodeNetherlands CountryCode = iota + 528
+	// CountryCodeBelgium is a CountryCode of type Belgium.
+	CountryCodeBelgium CountryCode = iota + 55
+	// CountryCodeGermany is a CountryCode of type Germany.
+	CountryCodeGermany CountryCode = iota + 274
+	// CountryCodeLuxembourg is a CountryCode of type Luxembourg.
+	CountryCodeLuxembourg CountryCode = iota + 439
+)
+
+const _CountryCodeName = "NetherlandsBelgiumGermanyLuxembourg"
+
+var _CountryCodeMap = map[CountryCode]string{
+	CountryCodeNetherlands: _CountryCodeName[0:11],
+	CountryCodeBelgium:     _CountryCodeName[11:18],
+	CountryCodeGermany:     _CountryCodeName[18:25],
+	CountryCodeLuxembourg:  _CountryCodeName[25:35],
+}
+
+// String implements the Stringer interface.
+func (x CountryCode) String() string {
+	if str, ok := _CountryCodeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("CountryCode(%d)", x)
+}
+
+var _CountryCodeValue = map[string]CountryCode{
+	_CountryCodeName[0:11]:  CountryCodeNetherlands,
+	_CountryCodeName[11:18]: CountryCodeBelgium,
+	_CountryCodeName[18:25]: CountryCodeGermany,
+	_CountryCodeName[25:35]: CountryCodeLuxembourg,
+}
+
+// ParseCountryCode attempts to convert a string to a CountryCode.
+func ParseCountryCode(name string) (CountryCode, error) {
+	if x, ok := _CountryCodeValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return CountryCode(0), fmt.Errorf("%q is not a valid CountryCode", name)
+}
@@ -0,0 +1,20 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountryCodeCSVResource(t *testing.T) {
+	assert.Equal(t, CountryCode(528), CountryCodeNetherlands)
+	assert.Equal(t, CountryCode(56), CountryCodeBelgium)
+	assert.Equal(t, CountryCode(276), CountryCodeGermany)
+	assert.Equal(t, CountryCode(442), CountryCodeLuxembourg)
+
+	x, err := ParseCountryCode("Belgium")
+	require.NoError(t, err)
+	assert.Equal(t, CountryCodeBelgium, x)
+	assert.Equal(t, "Germany", CountryCode(276).String())
+}
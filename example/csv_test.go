@@ -0,0 +1,44 @@
+package example
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShipmentCSV(t *testing.T) {
+	for _, x := range []Shipment{ShipmentPending, ShipmentShipped, ShipmentDelivered} {
+		assert.Equal(t, x.String(), x.CSVString())
+
+		parsed, err := ParseShipmentCSV(x.CSVString())
+		require.NoError(t, err)
+		assert.Equal(t, x, parsed)
+
+		quoted, err := ParseShipmentCSV(`"` + x.String() + `"`)
+		require.NoError(t, err)
+		assert.Equal(t, x, quoted)
+	}
+
+	assert.Equal(t, "shipped", ShipmentShipped.CSVString())
+
+	_, err := ParseShipmentCSV(`"lost"`)
+	assert.EqualError(t, err, "\"lost\" is not a valid Shipment")
+}
+
+func TestShipmentCSVRoundTrip(t *testing.T) {
+	// Fields containing commas are quoted by the csv writer, and unquoted again by the reader.
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	require.NoError(t, w.Write([]string{ShipmentPending.CSVString(), "a, b", ShipmentDelivered.CSVString()}))
+	w.Flush()
+	assert.Equal(t, "pending,\"a, b\",delivered\n", buf.String())
+
+	record, err := csv.NewReader(buf).Read()
+	require.NoError(t, err)
+	x, err := ParseShipmentCSV(record[2])
+	require.NoError(t, err)
+	assert.Equal(t, ShipmentDelivered, x)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 )
 
+// Products of AcmeInc
 const (
 	// AcmeIncProductAnvil is a Product of type Anvil.
 	AcmeIncProductAnvil Product = iota
@@ -46,5 +47,9 @@ func ParseProduct(name string) (Product, error) {
 	if x, ok := _ProductValue[name]; ok {
 		return x, nil
 	}
-	return Product(0), fmt.Errorf("%s is not a valid Product", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Product(0), fmt.Errorf("%q is not a valid Product", name)
 }
@@ -0,0 +1,13 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --definitions --weights
+
+package example
+
+// Plan is an enumeration of subscription plans, served to the frontend with their descriptions.
+/*
+ENUM(
+free // No payment details required
+pro = 10 // Billed monthly weight=2
+legacy // Deprecated: moved to pro
+)
+*/
+type Plan int
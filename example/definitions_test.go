@@ -0,0 +1,23 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDefinitions(t *testing.T) {
+	b, err := json.Marshal(PlanDefinitions())
+	require.NoError(t, err)
+	assert.Equal(t, `[`+
+		`{"name":"free","value":0,"description":"No payment details required","deprecated":false},`+
+		`{"name":"pro","value":10,"description":"Billed monthly","deprecated":false},`+
+		`{"name":"legacy","value":11,"description":"moved to pro","deprecated":true}`+
+		`]`, string(b))
+
+	defs := PlanDefinitions()
+	defs[0].Name = "changed"
+	assert.Equal(t, "free", PlanDefinitions()[0].Name)
+}
@@ -0,0 +1,14 @@
+//go:generate ../bin/go-enum -f=$GOFILE --deprecationhook --nocase
+
+package example
+
+// PaymentMethod is an enumeration of payment methods, some of which are being phased out.
+/*
+ENUM(
+card
+cheque // Deprecated: cheques are no longer accepted after 2027.
+transfer
+fax // Deprecated: use transfer instead.
+)
+*/
+type PaymentMethod int
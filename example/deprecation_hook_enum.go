@@ -0,0 +1,87 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PaymentMethod is an enumeration of payment methods, some of which are being phased out.
+const (
+	// PaymentMethodCard is a PaymentMethod of type Card.
+	PaymentMethodCard PaymentMethod = iota
+	// PaymentMethodCheque is a PaymentMethod of type Cheque.
+	// Deprecated: cheques are no longer accepted after 2027.
+	PaymentMethodCheque
+	// PaymentMethodTransfer is a PaymentMethod of type Transfer.
+	PaymentMethodTransfer
+	// PaymentMethodFax is a PaymentMethod of type Fax.
+	// Deprecated: use transfer instead.
+	PaymentMethodFax
+)
+
+const _PaymentMethodName = "cardchequetransferfax"
+
+var _PaymentMethodMap = map[PaymentMethod]string{
+	PaymentMethodCard:     _PaymentMethodName[0:4],
+	PaymentMethodCheque:   _PaymentMethodName[4:10],
+	PaymentMethodTransfer: _PaymentMethodName[10:18],
+	PaymentMethodFax:      _PaymentMethodName[18:21],
+}
+
+// String implements the Stringer interface.
+func (x PaymentMethod) String() string {
+	if str, ok := _PaymentMethodMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("PaymentMethod(%d)", x)
+}
+
+var _PaymentMethodValue = map[string]PaymentMethod{
+	_PaymentMethodName[0:4]:                    PaymentMethodCard,
+	strings.ToLower(_PaymentMethodName[0:4]):   PaymentMethodCard,
+	_PaymentMethodName[4:10]:                   PaymentMethodCheque,
+	strings.ToLower(_PaymentMethodName[4:10]):  PaymentMethodCheque,
+	_PaymentMethodName[10:18]:                  PaymentMethodTransfer,
+	strings.ToLower(_PaymentMethodName[10:18]): PaymentMethodTransfer,
+	_PaymentMethodName[18:21]:                  PaymentMethodFax,
+	strings.ToLower(_PaymentMethodName[18:21]): PaymentMethodFax,
+}
+
+// ParsePaymentMethod attempts to convert a string to a PaymentMethod.
+func ParsePaymentMethod(name string) (PaymentMethod, error) {
+	if x, ok := _PaymentMethodValue[name]; ok {
+		_PaymentMethodCheckDeprecated(x)
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _PaymentMethodValue[strings.ToLower(name)]; ok {
+		_PaymentMethodCheckDeprecated(x)
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return PaymentMethod(0), fmt.Errorf("%q is not a valid PaymentMethod", name)
+}
+
+// PaymentMethodDeprecationHook, when set, is called by ParsePaymentMethod with every deprecated PaymentMethod it parses.
+var PaymentMethodDeprecationHook func(PaymentMethod)
+
+var _PaymentMethodDeprecated = map[PaymentMethod]bool{
+	PaymentMethodCheque: true,
+	PaymentMethodFax:    true,
+}
+
+// _PaymentMethodCheckDeprecated calls the deprecation hook if it is set and x is deprecated.
+func _PaymentMethodCheckDeprecated(x PaymentMethod) {
+	if PaymentMethodDeprecationHook != nil && _PaymentMethodDeprecated[x] {
+		PaymentMethodDeprecationHook(x)
+	}
+}
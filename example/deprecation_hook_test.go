@@ -0,0 +1,26 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentMethodDeprecationHook(t *testing.T) {
+	// Without a hook, deprecated values parse like any other.
+	x, err := ParsePaymentMethod("fax")
+	require.NoError(t, err)
+	assert.Equal(t, PaymentMethodFax, x)
+
+	var seen []PaymentMethod
+	PaymentMethodDeprecationHook = func(x PaymentMethod) {
+		seen = append(seen, x)
+	}
+	defer func() { PaymentMethodDeprecationHook = nil }()
+
+	for _, name := range []string{"card", "cheque", "transfer", "FAX", "bitcoin"} {
+		_, _ = ParsePaymentMethod(name)
+	}
+	assert.Equal(t, []PaymentMethod{PaymentMethodCheque, PaymentMethodFax}, seen)
+}
@@ -0,0 +1,13 @@
+//go:generate ../bin/go-enum -f=$GOFILE --descriptions
+
+package example
+
+// Tariff is a subscription tariff.
+/*
+ENUM(
+free // Free forever, with ads
+pro // Pro: 50% off the first year
+team
+)
+*/
+type Tariff int
@@ -0,0 +1,70 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Tariff is a subscription tariff.
+const (
+	// TariffFree is a Tariff of type Free.
+	// Free forever, with ads
+	TariffFree Tariff = iota
+	// TariffPro is a Tariff of type Pro.
+	// Pro: 50% off the first year
+	TariffPro
+	// TariffTeam is a Tariff of type Team.
+	TariffTeam
+)
+
+const _TariffName = "freeproteam"
+
+var _TariffMap = map[Tariff]string{
+	TariffFree: _TariffName[0:4],
+	TariffPro:  _TariffName[4:7],
+	TariffTeam: _TariffName[7:11],
+}
+
+// String implements the Stringer interface.
+func (x Tariff) String() string {
+	if str, ok := _TariffMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Tariff(%d)", x)
+}
+
+var _TariffValue = map[string]Tariff{
+	_TariffName[0:4]:  TariffFree,
+	_TariffName[4:7]:  TariffPro,
+	_TariffName[7:11]: TariffTeam,
+}
+
+// ParseTariff attempts to convert a string to a Tariff.
+func ParseTariff(name string) (Tariff, error) {
+	if x, ok := _TariffValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Tariff(0), fmt.Errorf("%q is not a valid Tariff", name)
+}
+
+var _TariffDescriptions = map[Tariff]string{
+	TariffFree: "Free forever, with ads",
+	TariffPro:  "Pro: 50% off the first year",
+}
+
+// Description returns the comment declared with the Tariff, or its string form when it has none.
+func (x Tariff) Description() string {
+	if str, ok := _TariffDescriptions[x]; ok {
+		return str
+	}
+	return x.String()
+}
@@ -0,0 +1,14 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTariffDescription(t *testing.T) {
+	assert.Equal(t, "Free forever, with ads", TariffFree.Description())
+	assert.Equal(t, "Pro: 50% off the first year", TariffPro.Description())
+	assert.Equal(t, "team", TariffTeam.Description(), "values without a comment fall back to their string form")
+	assert.Equal(t, "Tariff(7)", Tariff(7).Description())
+}
@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --emptyas=unspecified
+
+package example
+
+// ENUM(unspecified, small, large)
+type ShirtSize int
@@ -0,0 +1,57 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// ShirtSizeUnspecified is a ShirtSize of type Unspecified.
+	ShirtSizeUnspecified ShirtSize = iota
+	// ShirtSizeSmall is a ShirtSize of type Small.
+	ShirtSizeSmall
+	// ShirtSizeLarge is a ShirtSize of type Large.
+	ShirtSizeLarge
+)
+
+const _ShirtSizeName = "unspecifiedsmalllarge"
+
+var _ShirtSizeMap = map[ShirtSize]string{
+	ShirtSizeUnspecified: _ShirtSizeName[0:11],
+	ShirtSizeSmall:       _ShirtSizeName[11:16],
+	ShirtSizeLarge:       _ShirtSizeName[16:21],
+}
+
+// String implements the Stringer interface.
+func (x ShirtSize) String() string {
+	if str, ok := _ShirtSizeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ShirtSize(%d)", x)
+}
+
+var _ShirtSizeValue = map[string]ShirtSize{
+	_ShirtSizeName[0:11]:  ShirtSizeUnspecified,
+	_ShirtSizeName[11:16]: ShirtSizeSmall,
+	_ShirtSizeName[16:21]: ShirtSizeLarge,
+}
+
+// ParseShirtSize attempts to convert a string to a ShirtSize.
+func ParseShirtSize(name string) (ShirtSize, error) {
+	if name == "" {
+		return ShirtSizeUnspecified, nil
+	}
+	if x, ok := _ShirtSizeValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ShirtSize(0), fmt.Errorf("%q is not a valid ShirtSize", name)
+}
@@ -0,0 +1,40 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShirtSizeEmptyAs(t *testing.T) {
+	tests := map[string]struct {
+		input  string
+		output ShirtSize
+		err    string
+	}{
+		"empty": {
+			input:  "",
+			output: ShirtSizeUnspecified,
+		},
+		"valid": {
+			input:  "large",
+			output: ShirtSizeLarge,
+		},
+		"invalid": {
+			input: "bogus",
+			err:   "\"bogus\" is not a valid ShirtSize",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			output, err := ParseShirtSize(tc.input)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.output, output)
+		})
+	}
+}
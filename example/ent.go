@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --ent --marshal
+
+package example
+
+// ENUM(draft, published, _, archived)
+type ArticleStatus int
@@ -0,0 +1,95 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// ArticleStatusDraft is a ArticleStatus of type Draft.
+	ArticleStatusDraft ArticleStatus = iota
+	// ArticleStatusPublished is a ArticleStatus of type Published.
+	ArticleStatusPublished
+	// Skipped value.
+	_
+	// ArticleStatusArchived is a ArticleStatus of type Archived.
+	ArticleStatusArchived
+)
+
+const _ArticleStatusName = "draftpublishedarchived"
+
+var _ArticleStatusMap = map[ArticleStatus]string{
+	ArticleStatusDraft:     _ArticleStatusName[0:5],
+	ArticleStatusPublished: _ArticleStatusName[5:14],
+	ArticleStatusArchived:  _ArticleStatusName[14:22],
+}
+
+// String implements the Stringer interface.
+func (x ArticleStatus) String() string {
+	if str, ok := _ArticleStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ArticleStatus(%d)", x)
+}
+
+var _ArticleStatusValue = map[string]ArticleStatus{
+	_ArticleStatusName[0:5]:   ArticleStatusDraft,
+	_ArticleStatusName[5:14]:  ArticleStatusPublished,
+	_ArticleStatusName[14:22]: ArticleStatusArchived,
+}
+
+// ParseArticleStatus attempts to convert a string to a ArticleStatus.
+func ParseArticleStatus(name string) (ArticleStatus, error) {
+	if x, ok := _ArticleStatusValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ArticleStatus(0), fmt.Errorf("%q is not a valid ArticleStatus", name)
+}
+
+var _ArticleStatusValues = []ArticleStatus{
+	ArticleStatusDraft,
+	ArticleStatusPublished,
+	ArticleStatusArchived,
+}
+
+// ArticleStatusValues returns a list of the values of ArticleStatus.
+// The list is built once, and every call returns a copy of it that is safe to modify.
+func ArticleStatusValues() []ArticleStatus {
+	tmp := make([]ArticleStatus, len(_ArticleStatusValues))
+	copy(tmp, _ArticleStatusValues)
+	return tmp
+}
+
+// Values implements the entgo.io/ent/schema/field EnumValues interface.
+func (ArticleStatus) Values() []string {
+	names := make([]string, 0, len(_ArticleStatusValues))
+	for _, x := range _ArticleStatusValues {
+		names = append(names, x.String())
+	}
+	return names
+}
+
+// MarshalText implements the text marshaller method.
+func (x ArticleStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ArticleStatus) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseArticleStatus(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,46 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// entEnumValues mirrors the field.EnumValues interface from entgo.io/ent/schema/field.
+type entEnumValues interface {
+	Values() []string
+}
+
+func TestArticleStatusEntValues(t *testing.T) {
+	var x interface{} = ArticleStatusDraft
+	ev, ok := x.(entEnumValues)
+	assert.True(t, ok, "ArticleStatus should implement the ent EnumValues interface")
+	assert.Equal(t, []string{"draft", "published", "archived"}, ev.Values())
+
+	assert.Equal(t, []ArticleStatus{ArticleStatusDraft, ArticleStatusPublished, ArticleStatusArchived}, ArticleStatusValues())
+
+	// The typed values are a copy, so changing them doesn't affect later calls.
+	values := ArticleStatusValues()
+	values[0] = ArticleStatusArchived
+	assert.Equal(t, ArticleStatusDraft, ArticleStatusValues()[0])
+}
+
+var (
+	articleStatusValuesSink []ArticleStatus
+	articleStatusNamesSink  []string
+)
+
+func BenchmarkArticleStatusValues(b *testing.B) {
+	b.Run("typed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			articleStatusValuesSink = ArticleStatusValues()
+		}
+	})
+	b.Run("ent", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			articleStatusNamesSink = ArticleStatusDraft.Values()
+		}
+	})
+}
@@ -56,6 +56,7 @@ var _Enum32bitNames = []string{
 }
 
 // Enum32bitNames returns a list of possible string values of Enum32bit.
+// The list is built once, and every call returns a copy of it that is safe to modify.
 func Enum32bitNames() []string {
 	tmp := make([]string, len(_Enum32bitNames))
 	copy(tmp, _Enum32bitNames)
@@ -105,5 +106,9 @@ func ParseEnum32bit(name string) (Enum32bit, error) {
 	if x, ok := _Enum32bitValue[name]; ok {
 		return x, nil
 	}
-	return Enum32bit(0), fmt.Errorf("%s is not a valid Enum32bit, try [%s]", name, strings.Join(_Enum32bitNames, ", "))
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Enum32bit(0), fmt.Errorf("%q is not a valid Enum32bit, try [%s]", name, strings.Join(_Enum32bitNames, ", "))
 }
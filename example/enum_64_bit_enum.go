@@ -68,6 +68,7 @@ var _Enum64bitNames = []string{
 }
 
 // Enum64bitNames returns a list of possible string values of Enum64bit.
+// The list is built once, and every call returns a copy of it that is safe to modify.
 func Enum64bitNames() []string {
 	tmp := make([]string, len(_Enum64bitNames))
 	copy(tmp, _Enum64bitNames)
@@ -125,5 +126,9 @@ func ParseEnum64bit(name string) (Enum64bit, error) {
 	if x, ok := _Enum64bitValue[name]; ok {
 		return x, nil
 	}
-	return Enum64bit(0), fmt.Errorf("%s is not a valid Enum64bit, try [%s]", name, strings.Join(_Enum64bitNames, ", "))
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Enum64bit(0), fmt.Errorf("%q is not a valid Enum64bit, try [%s]", name, strings.Join(_Enum64bitNames, ", "))
 }
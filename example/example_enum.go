@@ -11,6 +11,7 @@ import (
 	"strings"
 )
 
+// Make x
 const (
 	// MakeToyota is a Make of type Toyota.
 	MakeToyota Make = iota
@@ -73,6 +74,7 @@ var _MakeNames = []string{
 }
 
 // MakeNames returns a list of possible string values of Make.
+// The list is built once, and every call returns a copy of it that is safe to modify.
 func MakeNames() []string {
 	tmp := make([]string, len(_MakeNames))
 	copy(tmp, _MakeNames)
@@ -135,7 +137,11 @@ func ParseMake(name string) (Make, error) {
 	if x, ok := _MakeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return Make(0), fmt.Errorf("%s is not a valid Make, try [%s]", name, strings.Join(_MakeNames, ", "))
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Make(0), fmt.Errorf("%q is not a valid Make, try [%s]", name, strings.Join(_MakeNames, ", "))
 }
 
 // MarshalText implements the text marshaller method.
@@ -171,6 +177,7 @@ func (x *Make) Type() string {
 	return "Make"
 }
 
+// Make x
 const (
 	// NoZerosStart is a NoZeros of type Start.
 	NoZerosStart NoZeros = iota + 20
@@ -198,6 +205,7 @@ var _NoZerosNames = []string{
 }
 
 // NoZerosNames returns a list of possible string values of NoZeros.
+// The list is built once, and every call returns a copy of it that is safe to modify.
 func NoZerosNames() []string {
 	tmp := make([]string, len(_NoZerosNames))
 	copy(tmp, _NoZerosNames)
@@ -245,7 +253,11 @@ func ParseNoZeros(name string) (NoZeros, error) {
 	if x, ok := _NoZerosValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return NoZeros(0), fmt.Errorf("%s is not a valid NoZeros, try [%s]", name, strings.Join(_NoZerosNames, ", "))
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return NoZeros(0), fmt.Errorf("%q is not a valid NoZeros, try [%s]", name, strings.Join(_NoZerosNames, ", "))
 }
 
 // MarshalText implements the text marshaller method.
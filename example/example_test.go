@@ -127,7 +127,7 @@ var makeTests = []struct {
 		input:         `{"make":"Porsche"}`,
 		output:        &makeTest{M: MakeVolkswagon},
 		errorExpected: true,
-		err:           errors.New("Porsche is not a valid Make, try [Toyota, Chevy, Ford, Tesla, Hyundai, Nissan, Jaguar, Audi, BMW, Mercedes-Benz, Volkswagon]"),
+		err:           errors.New("\"Porsche\" is not a valid Make, try [Toyota, Chevy, Ford, Tesla, Hyundai, Nissan, Jaguar, Audi, BMW, Mercedes-Benz, Volkswagon]"),
 	},
 }
 
@@ -183,7 +183,7 @@ func TestNoZeroValues(t *testing.T) {
 		assert.Len(tt, NoZerosNames(), 6)
 
 		_, err := ParseNoZeros("pppps")
-		assert.EqualError(tt, err, "pppps is not a valid NoZeros, try [start, middle, end, ps, pps, ppps]")
+		assert.EqualError(tt, err, "\"pppps\" is not a valid NoZeros, try [start, middle, end, ps, pps, ppps]")
 
 		tmp, _ := ParseNoZeros("ppps")
 		assert.Equal(tt, NoZerosPpps, tmp)
@@ -194,7 +194,7 @@ func TestNoZeroValues(t *testing.T) {
 		val := map[string]*NoZeros{}
 
 		err = json.Unmarshal([]byte(`{"nz":"pppps"}`), &val)
-		assert.EqualError(tt, err, "pppps is not a valid NoZeros, try [start, middle, end, ps, pps, ppps]")
+		assert.EqualError(tt, err, "\"pppps\" is not a valid NoZeros, try [start, middle, end, ps, pps, ppps]")
 
 	})
 
@@ -252,7 +252,7 @@ func BenchmarkMakeParse(b *testing.B) {
 		"failure": {
 			input:  "xyz",
 			output: MakeToyota,
-			err:    errors.New("xyz is not a valid Make, try [Toyota, Chevy, Ford, Tesla, Hyundai, Nissan, Jaguar, Audi, BMW, Mercedes-Benz, Volkswagon]"),
+			err:    errors.New("\"xyz\" is not a valid Make, try [Toyota, Chevy, Ford, Tesla, Hyundai, Nissan, Jaguar, Audi, BMW, Mercedes-Benz, Volkswagon]"),
 		},
 	}
 
@@ -274,3 +274,21 @@ func BenchmarkMakeParse(b *testing.B) {
 	}
 
 }
+
+var makeNamesSink []string
+
+func BenchmarkMakeNames(b *testing.B) {
+	b.Run("copy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			makeNamesSink = MakeNames()
+		}
+	})
+	b.Run("shared", func(b *testing.B) {
+		// The cached list MakeNames copies from, which is never rebuilt.
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			makeNamesSink = _MakeNames
+		}
+	})
+}
@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --expvar
+
+package example
+
+// ENUM(starting, serving, draining, stopped)
+type ServerState int
@@ -0,0 +1,86 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+const (
+	// ServerStateStarting is a ServerState of type Starting.
+	ServerStateStarting ServerState = iota
+	// ServerStateServing is a ServerState of type Serving.
+	ServerStateServing
+	// ServerStateDraining is a ServerState of type Draining.
+	ServerStateDraining
+	// ServerStateStopped is a ServerState of type Stopped.
+	ServerStateStopped
+)
+
+const _ServerStateName = "startingservingdrainingstopped"
+
+var _ServerStateMap = map[ServerState]string{
+	ServerStateStarting: _ServerStateName[0:8],
+	ServerStateServing:  _ServerStateName[8:15],
+	ServerStateDraining: _ServerStateName[15:23],
+	ServerStateStopped:  _ServerStateName[23:30],
+}
+
+// String implements the Stringer interface.
+func (x ServerState) String() string {
+	if str, ok := _ServerStateMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ServerState(%d)", x)
+}
+
+var _ServerStateValue = map[string]ServerState{
+	_ServerStateName[0:8]:   ServerStateStarting,
+	_ServerStateName[8:15]:  ServerStateServing,
+	_ServerStateName[15:23]: ServerStateDraining,
+	_ServerStateName[23:30]: ServerStateStopped,
+}
+
+// ParseServerState attempts to convert a string to a ServerState.
+func ParseServerState(name string) (ServerState, error) {
+	if x, ok := _ServerStateValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ServerState(0), fmt.Errorf("%q is not a valid ServerState", name)
+}
+
+// ServerStateVar is an expvar.Var holding a ServerState, published as its JSON quoted name.
+type ServerStateVar struct {
+	mu sync.RWMutex
+	x  ServerState
+}
+
+// Set stores the ServerState to publish.
+func (v *ServerStateVar) Set(x ServerState) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.x = x
+}
+
+// Value returns the currently stored ServerState.
+func (v *ServerStateVar) Value() ServerState {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.x
+}
+
+// String implements the expvar.Var interface.
+func (v *ServerStateVar) String() string {
+	b, _ := json.Marshal(v.Value().String())
+	return string(b)
+}
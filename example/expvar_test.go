@@ -0,0 +1,28 @@
+package example
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ expvar.Var = &ServerStateVar{}
+
+func TestServerStateVar(t *testing.T) {
+	v := &ServerStateVar{}
+	assert.Equal(t, `"starting"`, v.String())
+
+	v.Set(ServerStateDraining)
+	assert.Equal(t, ServerStateDraining, v.Value())
+
+	var decoded string
+	require.True(t, json.Valid([]byte(v.String())))
+	require.NoError(t, json.Unmarshal([]byte(v.String()), &decoded))
+	assert.Equal(t, "draining", decoded)
+
+	v.Set(ServerState(42))
+	assert.Equal(t, `"ServerState(42)"`, v.String())
+}
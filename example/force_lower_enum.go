@@ -42,5 +42,9 @@ func ParseForceLowerType(name string) (ForceLowerType, error) {
 	if x, ok := _ForceLowerTypeValue[name]; ok {
 		return x, nil
 	}
-	return ForceLowerType(0), fmt.Errorf("%s is not a valid ForceLowerType", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ForceLowerType(0), fmt.Errorf("%q is not a valid ForceLowerType", name)
 }
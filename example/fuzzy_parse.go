@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --fuzzyparse
+
+package example
+
+// ImportState is the state of an imported record, whose labels come from several spreadsheets.
+// ENUM(to_do, in_progress, in review, done)
+type ImportState int
@@ -0,0 +1,78 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ImportState is the state of an imported record, whose labels come from several spreadsheets.
+const (
+	// ImportStateToDo is a ImportState of type To_do.
+	ImportStateToDo ImportState = iota
+	// ImportStateInProgress is a ImportState of type In_progress.
+	ImportStateInProgress
+	// ImportStateInReview is a ImportState of type In Review.
+	ImportStateInReview
+	// ImportStateDone is a ImportState of type Done.
+	ImportStateDone
+)
+
+const _ImportStateName = "to_doin_progressin reviewdone"
+
+var _ImportStateMap = map[ImportState]string{
+	ImportStateToDo:       _ImportStateName[0:5],
+	ImportStateInProgress: _ImportStateName[5:16],
+	ImportStateInReview:   _ImportStateName[16:25],
+	ImportStateDone:       _ImportStateName[25:29],
+}
+
+// String implements the Stringer interface.
+func (x ImportState) String() string {
+	if str, ok := _ImportStateMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ImportState(%d)", x)
+}
+
+var _ImportStateValue = map[string]ImportState{
+	_ImportStateName[0:5]:   ImportStateToDo,
+	_ImportStateName[5:16]:  ImportStateInProgress,
+	_ImportStateName[16:25]: ImportStateInReview,
+	_ImportStateName[25:29]: ImportStateDone,
+}
+
+// ParseImportState attempts to convert a string to a ImportState.
+func ParseImportState(name string) (ImportState, error) {
+	if x, ok := _ImportStateValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ImportState(0), fmt.Errorf("%q is not a valid ImportState", name)
+}
+
+var _ImportStateFuzzyValue = map[string]ImportState{
+	"done":       ImportStateDone,
+	"inprogress": ImportStateInProgress,
+	"inreview":   ImportStateInReview,
+	"todo":       ImportStateToDo,
+}
+
+var _ImportStateFuzzyReplacer = strings.NewReplacer(" ", "", "-", "", "_", "")
+
+// ParseImportStateFuzzy attempts to convert a loosely written label to a ImportState.
+// Case, spaces, hyphens and underscores are ignored when comparing it to the names.
+func ParseImportStateFuzzy(s string) (ImportState, error) {
+	if x, ok := _ImportStateFuzzyValue[strings.ToLower(_ImportStateFuzzyReplacer.Replace(s))]; ok {
+		return x, nil
+	}
+	return ImportState(0), fmt.Errorf("%s is not a valid ImportState", s)
+}
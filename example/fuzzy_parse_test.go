@@ -0,0 +1,30 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImportStateFuzzy(t *testing.T) {
+	tests := map[ImportState][]string{
+		ImportStateToDo:       {"to_do", "To Do", "TODO", "to-do", " todo "},
+		ImportStateInProgress: {"In-Progress", "in progress", "INPROGRESS", "in_progress", "In - Progress"},
+		ImportStateInReview:   {"in review", "In-Review", "inreview", "IN_REVIEW"},
+		ImportStateDone:       {"done", "Done", "D-O-N-E"},
+	}
+	for expected, inputs := range tests {
+		for _, input := range inputs {
+			x, err := ParseImportStateFuzzy(input)
+			require.NoError(t, err, input)
+			assert.Equal(t, expected, x, input)
+		}
+	}
+
+	_, err := ParseImportStateFuzzy("in.progress")
+	assert.EqualError(t, err, "in.progress is not a valid ImportState")
+
+	_, err = ParseImportState("In-Progress")
+	assert.Error(t, err, "the regular parse stays strict")
+}
@@ -138,5 +138,9 @@ func ParseLetter(name string) (Letter, error) {
 	if x, ok := _LetterValue[name]; ok {
 		return x, nil
 	}
-	return Letter(0), fmt.Errorf("%s is not a valid Letter", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Letter(0), fmt.Errorf("%q is not a valid Letter", name)
 }
@@ -74,5 +74,9 @@ func ParseNumber(name string) (Number, error) {
 	if x, ok := _NumberValue[name]; ok {
 		return x, nil
 	}
-	return Number(0), fmt.Errorf("%s is not a valid Number", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Number(0), fmt.Errorf("%q is not a valid Number", name)
 }
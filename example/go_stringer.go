@@ -0,0 +1,14 @@
+//go:generate ../bin/go-enum -f=$GOFILE --gostringer
+
+package example
+
+/*
+ENUM(
+north // canonical
+east
+south
+west
+up=0
+)
+*/
+type Compass int
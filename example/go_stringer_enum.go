@@ -0,0 +1,77 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// CompassNorth is a Compass of type North.
+	// canonical
+	CompassNorth Compass = iota
+	// CompassEast is a Compass of type East.
+	CompassEast
+	// CompassSouth is a Compass of type South.
+	CompassSouth
+	// CompassWest is a Compass of type West.
+	CompassWest
+	// CompassUp is a Compass of type Up.
+	CompassUp Compass = iota + -4
+)
+
+const _CompassName = "northeastsouthwestup"
+
+var _CompassMap = map[Compass]string{
+	CompassNorth: _CompassName[0:5],
+	CompassEast:  _CompassName[5:9],
+	CompassSouth: _CompassName[9:14],
+	CompassWest:  _CompassName[14:18],
+}
+
+// String implements the Stringer interface.
+func (x Compass) String() string {
+	if str, ok := _CompassMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Compass(%d)", x)
+}
+
+var _CompassValue = map[string]Compass{
+	_CompassName[0:5]:   CompassNorth,
+	_CompassName[5:9]:   CompassEast,
+	_CompassName[9:14]:  CompassSouth,
+	_CompassName[14:18]: CompassWest,
+	_CompassName[18:20]: CompassUp,
+}
+
+// ParseCompass attempts to convert a string to a Compass.
+func ParseCompass(name string) (Compass, error) {
+	if x, ok := _CompassValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Compass(0), fmt.Errorf("%q is not a valid Compass", name)
+}
+
+var _CompassGoNames = map[Compass]string{
+	CompassNorth: "CompassNorth",
+	CompassEast:  "CompassEast",
+	CompassSouth: "CompassSouth",
+	CompassWest:  "CompassWest",
+}
+
+// GoString implements the GoStringer interface, returning the constant name for %#v.
+func (x Compass) GoString() string {
+	if str, ok := _CompassGoNames[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Compass(%d)", x)
+}
@@ -0,0 +1,21 @@
+package example
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompassGoString(t *testing.T) {
+	assert.Equal(t, "CompassEast", CompassEast.GoString())
+	// Names sharing a value use the first one declared.
+	assert.Equal(t, "CompassNorth", CompassUp.GoString())
+	assert.Equal(t, "Compass(9)", Compass(9).GoString())
+
+	type heading struct {
+		Direction Compass
+	}
+	assert.Equal(t, "example.heading{Direction:CompassWest}", fmt.Sprintf("%#v", heading{Direction: CompassWest}))
+	assert.Equal(t, "example.heading{Direction:Compass(-1)}", fmt.Sprintf("%#v", heading{Direction: Compass(-1)}))
+}
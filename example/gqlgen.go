@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum -f=$GOFILE --gqlgen
+
+package example
+
+// Episode is bound to the Episode enum of the graphql schema.
+// ENUM(NEWHOPE, EMPIRE, JEDI)
+type Episode int
+
+// Role is bound to the Role enum of the graphql schema.
+// ENUM(ADMIN, EDITOR, VIEWER)
+type Role string
@@ -0,0 +1,35 @@
+package example
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpisodeGQL(t *testing.T) {
+	var buf bytes.Buffer
+	EpisodeEMPIRE.MarshalGQL(&buf)
+	assert.Equal(t, `"EMPIRE"`, buf.String())
+
+	var x Episode
+	require.NoError(t, x.UnmarshalGQL("JEDI"))
+	assert.Equal(t, EpisodeJEDI, x)
+
+	assert.EqualError(t, x.UnmarshalGQL(2), "int is not a valid Episode")
+	assert.EqualError(t, x.UnmarshalGQL("SITH"), "\"SITH\" is not a valid Episode")
+	assert.Equal(t, EpisodeJEDI, x, "a failed unmarshal leaves the value untouched")
+}
+
+func TestRoleGQL(t *testing.T) {
+	var buf bytes.Buffer
+	RoleEDITOR.MarshalGQL(&buf)
+	assert.Equal(t, `"EDITOR"`, buf.String())
+
+	var x Role
+	require.NoError(t, x.UnmarshalGQL("VIEWER"))
+	assert.Equal(t, RoleVIEWER, x)
+
+	assert.EqualError(t, x.UnmarshalGQL(nil), "<nil> is not a valid Role")
+}
@@ -0,0 +1,14 @@
+//go:generate ../bin/go-enum -f=$GOFILE --hexcolor
+
+package example
+
+// BuildStatus is an enumeration of CI build states, with the color they are shown in.
+/*
+ENUM(
+pending
+running // hex=#1e90ff
+passed // Finished without errors hex=#0f0
+failed // hex=#FF0000
+)
+*/
+type BuildStatus int
@@ -0,0 +1,73 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// BuildStatus is an enumeration of CI build states, with the color they are shown in.
+const (
+	// BuildStatusPending is a BuildStatus of type Pending.
+	BuildStatusPending BuildStatus = iota
+	// BuildStatusRunning is a BuildStatus of type Running.
+	// hex=#1e90ff
+	BuildStatusRunning
+	// BuildStatusPassed is a BuildStatus of type Passed.
+	// Finished without errors hex=#0f0
+	BuildStatusPassed
+	// BuildStatusFailed is a BuildStatus of type Failed.
+	// hex=#FF0000
+	BuildStatusFailed
+)
+
+const _BuildStatusName = "pendingrunningpassedfailed"
+
+var _BuildStatusMap = map[BuildStatus]string{
+	BuildStatusPending: _BuildStatusName[0:7],
+	BuildStatusRunning: _BuildStatusName[7:14],
+	BuildStatusPassed:  _BuildStatusName[14:20],
+	BuildStatusFailed:  _BuildStatusName[20:26],
+}
+
+// String implements the Stringer interface.
+func (x BuildStatus) String() string {
+	if str, ok := _BuildStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("BuildStatus(%d)", x)
+}
+
+var _BuildStatusValue = map[string]BuildStatus{
+	_BuildStatusName[0:7]:   BuildStatusPending,
+	_BuildStatusName[7:14]:  BuildStatusRunning,
+	_BuildStatusName[14:20]: BuildStatusPassed,
+	_BuildStatusName[20:26]: BuildStatusFailed,
+}
+
+// ParseBuildStatus attempts to convert a string to a BuildStatus.
+func ParseBuildStatus(name string) (BuildStatus, error) {
+	if x, ok := _BuildStatusValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return BuildStatus(0), fmt.Errorf("%q is not a valid BuildStatus", name)
+}
+
+var _BuildStatusHexColors = map[BuildStatus]string{
+	BuildStatusRunning: "#1e90ff",
+	BuildStatusPassed:  "#0f0",
+	BuildStatusFailed:  "#FF0000",
+}
+
+// HexColor returns the hex color declared for the BuildStatus, or an empty string if it has none.
+func (x BuildStatus) HexColor() string {
+	return _BuildStatusHexColors[x]
+}
@@ -0,0 +1,15 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildStatusHexColor(t *testing.T) {
+	assert.Equal(t, "", BuildStatusPending.HexColor())
+	assert.Equal(t, "#1e90ff", BuildStatusRunning.HexColor())
+	assert.Equal(t, "#0f0", BuildStatusPassed.HexColor())
+	assert.Equal(t, "#FF0000", BuildStatusFailed.HexColor())
+	assert.Equal(t, "", BuildStatus(9).HexColor())
+}
@@ -0,0 +1,14 @@
+//go:generate ../bin/go-enum -f=$GOFILE --httpstatus --httpstatusdefault=503
+
+package example
+
+// ErrorKind is an enumeration of API errors, with the HTTP status they are reported with.
+/*
+ENUM(
+unknown
+not_found // httpStatus=404
+conflict // The resource changed in between httpStatus=409
+invalid_input // httpStatus=400
+)
+*/
+type ErrorKind int
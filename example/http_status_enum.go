@@ -0,0 +1,76 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// ErrorKind is an enumeration of API errors, with the HTTP status they are reported with.
+const (
+	// ErrorKindUnknown is a ErrorKind of type Unknown.
+	ErrorKindUnknown ErrorKind = iota
+	// ErrorKindNotFound is a ErrorKind of type Not_found.
+	// httpStatus=404
+	ErrorKindNotFound
+	// ErrorKindConflict is a ErrorKind of type Conflict.
+	// The resource changed in between httpStatus=409
+	ErrorKindConflict
+	// ErrorKindInvalidInput is a ErrorKind of type Invalid_input.
+	// httpStatus=400
+	ErrorKindInvalidInput
+)
+
+const _ErrorKindName = "unknownnot_foundconflictinvalid_input"
+
+var _ErrorKindMap = map[ErrorKind]string{
+	ErrorKindUnknown:      _ErrorKindName[0:7],
+	ErrorKindNotFound:     _ErrorKindName[7:16],
+	ErrorKindConflict:     _ErrorKindName[16:24],
+	ErrorKindInvalidInput: _ErrorKindName[24:37],
+}
+
+// String implements the Stringer interface.
+func (x ErrorKind) String() string {
+	if str, ok := _ErrorKindMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ErrorKind(%d)", x)
+}
+
+var _ErrorKindValue = map[string]ErrorKind{
+	_ErrorKindName[0:7]:   ErrorKindUnknown,
+	_ErrorKindName[7:16]:  ErrorKindNotFound,
+	_ErrorKindName[16:24]: ErrorKindConflict,
+	_ErrorKindName[24:37]: ErrorKindInvalidInput,
+}
+
+// ParseErrorKind attempts to convert a string to a ErrorKind.
+func ParseErrorKind(name string) (ErrorKind, error) {
+	if x, ok := _ErrorKindValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ErrorKind(0), fmt.Errorf("%q is not a valid ErrorKind", name)
+}
+
+var _ErrorKindHTTPStatuses = map[ErrorKind]int{
+	ErrorKindNotFound:     404,
+	ErrorKindConflict:     409,
+	ErrorKindInvalidInput: 400,
+}
+
+// HTTPStatus returns the HTTP status code declared for the ErrorKind, or 503 if it has none.
+func (x ErrorKind) HTTPStatus() int {
+	if status, ok := _ErrorKindHTTPStatuses[x]; ok {
+		return status
+	}
+	return 503
+}
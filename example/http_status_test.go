@@ -0,0 +1,16 @@
+package example
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorKindHTTPStatus(t *testing.T) {
+	assert.Equal(t, http.StatusServiceUnavailable, ErrorKindUnknown.HTTPStatus())
+	assert.Equal(t, http.StatusNotFound, ErrorKindNotFound.HTTPStatus())
+	assert.Equal(t, http.StatusConflict, ErrorKindConflict.HTTPStatus())
+	assert.Equal(t, http.StatusBadRequest, ErrorKindInvalidInput.HTTPStatus())
+	assert.Equal(t, http.StatusServiceUnavailable, ErrorKind(9).HTTPStatus())
+}
@@ -0,0 +1,100 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// These fixtures pin down how the generated marshallers behave when the enum is not marshalled on its own.
+// Text marshalled enums (Color), and enums with a MarshalJSON method (Gender, EventType), are covered.
+
+func TestJSONMapValues(t *testing.T) {
+	t.Run("text marshaller", func(t *testing.T) {
+		in := map[string]Color{"sky": ColorBlue, "grass": ColorGreen, "none": ColorBlack}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"sky":"Blue","grass":"Green","none":"Black"}`, string(b))
+
+		var out map[string]Color
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("json marshaller", func(t *testing.T) {
+		in := map[string]Gender{"a": GenderFemale, "b": GenderUnspecified}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"a":"female","b":""}`, string(b))
+
+		var out map[string]Gender
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("numeric passthrough", func(t *testing.T) {
+		in := map[string]EventType{"known": EventTypeDeleted, "unknown": EventType(42)}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"known":"deleted","unknown":42}`, string(b))
+
+		var out map[string]EventType
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("map key", func(t *testing.T) {
+		in := map[Color]int{ColorRed: 1, ColorWhite: 2}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"Red":1,"White":2}`, string(b))
+
+		var out map[Color]int
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+}
+
+func TestJSONOmitEmpty(t *testing.T) {
+	type fields struct {
+		Color  Color     `json:"color,omitempty"`
+		Gender Gender    `json:"gender,omitempty"`
+		Event  EventType `json:"event,omitempty"`
+	}
+
+	t.Run("zero values are empty", func(t *testing.T) {
+		b, err := json.Marshal(fields{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(b))
+
+		var out fields
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, fields{}, out)
+	})
+
+	t.Run("non zero values are kept", func(t *testing.T) {
+		in := fields{Color: ColorRed, Gender: GenderOther, Event: EventTypeUpdated}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"color":"Red","gender":"other","event":"updated"}`, string(b))
+
+		var out fields
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		type pointers struct {
+			Color *Color `json:"color,omitempty"`
+		}
+		b, err := json.Marshal(pointers{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(b))
+
+		b, err = json.Marshal(pointers{Color: ColorBlack.Ptr()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"color":"Black"}`, string(b), "a pointer to the zero value is not empty")
+	})
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal "--jsonzerorepr=\"\""
+
+package example
+
+// Gender is an enumeration of genders, where an API sends an empty string when it is unknown.
+// ENUM(unspecified, female, male, other)
+type Gender int
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --jsonzerorepr=null
+
+package example
+
+// Parity is an enumeration of serial port parities, where an API sends null when there is none.
+// ENUM(none, odd, even)
+type Parity int
@@ -0,0 +1,34 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonZeroProfile struct {
+	Gender Gender `json:"gender"`
+	Parity Parity `json:"parity"`
+}
+
+func TestJSONZeroRepr(t *testing.T) {
+	b, err := json.Marshal(jsonZeroProfile{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"gender":"","parity":null}`, string(b))
+
+	b, err = json.Marshal(jsonZeroProfile{Gender: GenderFemale, Parity: ParityOdd})
+	require.NoError(t, err)
+	assert.Equal(t, `{"gender":"female","parity":"odd"}`, string(b))
+
+	profile := jsonZeroProfile{Gender: GenderMale, Parity: ParityEven}
+	require.NoError(t, json.Unmarshal([]byte(`{"gender":"","parity":null}`), &profile))
+	assert.Equal(t, jsonZeroProfile{}, profile)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"gender":"other","parity":"even"}`), &profile))
+	assert.Equal(t, jsonZeroProfile{Gender: GenderOther, Parity: ParityEven}, profile)
+
+	assert.EqualError(t, json.Unmarshal([]byte(`{"gender":null}`), &profile), "\"\" is not a valid Gender")
+	assert.EqualError(t, json.Unmarshal([]byte(`{"parity":""}`), &profile), "\"\" is not a valid Parity")
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --sqlnullint --numericpassthrough
+
+package example
+
+// ShardKey identifies a shard by an id that does not fit in a float64 mantissa.
+// ENUM(primary = 1, overflow = 9007199254740993)
+type ShardKey uint64
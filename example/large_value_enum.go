@@ -0,0 +1,263 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ShardKey identifies a shard by an id that does not fit in a float64 mantissa.
+const (
+	// ShardKeyPrimary is a ShardKey of type Primary.
+	ShardKeyPrimary ShardKey = iota + 1
+	// ShardKeyOverflow is a ShardKey of type Overflow.
+	ShardKeyOverflow ShardKey = iota + 9007199254740992
+)
+
+const _ShardKeyName = "primaryoverflow"
+
+var _ShardKeyMap = map[ShardKey]string{
+	ShardKeyPrimary:  _ShardKeyName[0:7],
+	ShardKeyOverflow: _ShardKeyName[7:15],
+}
+
+// String implements the Stringer interface.
+func (x ShardKey) String() string {
+	if str, ok := _ShardKeyMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ShardKey(%d)", x)
+}
+
+var _ShardKeyValue = map[string]ShardKey{
+	_ShardKeyName[0:7]:  ShardKeyPrimary,
+	_ShardKeyName[7:15]: ShardKeyOverflow,
+}
+
+// ParseShardKey attempts to convert a string to a ShardKey.
+func ParseShardKey(name string) (ShardKey, error) {
+	if x, ok := _ShardKeyValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ShardKey(0), fmt.Errorf("%q is not a valid ShardKey", name)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ShardKey) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ShardKey) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseShardKey(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// MarshalJSON implements the json marshaller method.
+// Undefined values are written as their integer, so they are preserved through a round-trip.
+func (x ShardKey) MarshalJSON() ([]byte, error) {
+	if _, ok := _ShardKeyMap[x]; !ok {
+		return []byte(strconv.FormatUint(uint64(x), 10)), nil
+	}
+	return json.Marshal(x.String())
+}
+
+// UnmarshalJSON implements the json unmarshaller method.
+// Integers are stored as is, even if they are not a defined ShardKey.
+func (x *ShardKey) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] != '"' {
+		val, err := strconv.ParseUint(string(b), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid ShardKey: %w", b, err)
+		}
+		*x = ShardKey(val)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		return err
+	}
+	tmp, err := ParseShardKey(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var _ShardKeyErrNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ShardKey) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ShardKey(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = ShardKey(v)
+	case string:
+		*x, err = ParseShardKey(v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.ParseUint(v, 10, 64); verr == nil {
+				*x, err = ShardKey(val), nil
+			}
+		}
+	case []byte:
+		*x, err = ParseShardKey(string(v))
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.ParseUint(string(v), 10, 64); verr == nil {
+				*x, err = ShardKey(val), nil
+			}
+		}
+	case ShardKey:
+		*x = v
+	case int:
+		*x = ShardKey(v)
+	case *ShardKey:
+		if v == nil {
+			return _ShardKeyErrNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = ShardKey(v)
+	case uint64:
+		*x = ShardKey(v)
+	case *int:
+		if v == nil {
+			return _ShardKeyErrNilPtr
+		}
+		*x = ShardKey(*v)
+	case *int64:
+		if v == nil {
+			return _ShardKeyErrNilPtr
+		}
+		*x = ShardKey(*v)
+	case json.Number:
+		var val uint64
+		val, err = strconv.ParseUint(v.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid ShardKey: %w", v, err)
+		}
+		*x = ShardKey(val)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = ShardKey(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return _ShardKeyErrNilPtr
+		}
+		*x = ShardKey(*v)
+	case *uint:
+		if v == nil {
+			return _ShardKeyErrNilPtr
+		}
+		*x = ShardKey(*v)
+	case *uint64:
+		if v == nil {
+			return _ShardKeyErrNilPtr
+		}
+		*x = ShardKey(*v)
+	case *string:
+		if v == nil {
+			return _ShardKeyErrNilPtr
+		}
+		*x, err = ParseShardKey(*v)
+		if err != nil {
+			// try parsing the integer value as a string
+			if val, verr := strconv.ParseUint(*v, 10, 64); verr == nil {
+				*x, err = ShardKey(val), nil
+			}
+		}
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ShardKey) Value() (driver.Value, error) {
+	return int64(x), nil
+}
+
+type NullShardKey struct {
+	ShardKey ShardKey
+	Valid    bool
+	Set      bool
+}
+
+func NewNullShardKey(val interface{}) (x NullShardKey) {
+	x.Scan(val) // yes, we ignore this error, it will just be an invalid value.
+	return
+}
+
+// Scan implements the Scanner interface.
+func (x *NullShardKey) Scan(value interface{}) (err error) {
+	x.Set = true
+	if value == nil {
+		x.ShardKey, x.Valid = ShardKey(0), false
+		return
+	}
+
+	err = x.ShardKey.Scan(value)
+	x.Valid = (err == nil)
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x NullShardKey) Value() (driver.Value, error) {
+	if !x.Valid {
+		return nil, nil
+	}
+	// driver.Value accepts int64 for int values.
+	return int64(x.ShardKey), nil
+}
+
+// MarshalJSON correctly serializes a NullShardKey to JSON.
+func (n NullShardKey) MarshalJSON() ([]byte, error) {
+	const nullStr = "null"
+	if n.Valid {
+		return json.Marshal(n.ShardKey)
+	}
+	return []byte(nullStr), nil
+}
+
+// UnmarshalJSON correctly deserializes a NullShardKey from JSON.
+func (n *NullShardKey) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	var x interface{}
+	// Decode numbers as json.Number so that large values keep their exact digits.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err := dec.Decode(&x)
+	if err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("invalid JSON for NullShardKey, unexpected data after the value")
+	}
+	err = n.Scan(x)
+	return err
+}
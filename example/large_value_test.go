@@ -0,0 +1,48 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardKeyJSONPrecision(t *testing.T) {
+	const above53 = uint64(1<<53 + 1)
+	require.Equal(t, above53, uint64(ShardKeyOverflow))
+
+	t.Run("numeric", func(t *testing.T) {
+		var x ShardKey
+		require.NoError(t, json.Unmarshal([]byte(`9007199254740993`), &x))
+		assert.Equal(t, ShardKeyOverflow, x)
+
+		require.NoError(t, json.Unmarshal([]byte(`18446744073709551615`), &x))
+		assert.Equal(t, ShardKey(18446744073709551615), x)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		var x NullShardKey
+		require.NoError(t, json.Unmarshal([]byte(`9007199254740993`), &x))
+		assert.True(t, x.Valid)
+		assert.Equal(t, ShardKeyOverflow, x.ShardKey)
+
+		require.NoError(t, json.Unmarshal([]byte(`"overflow"`), &x))
+		assert.Equal(t, ShardKeyOverflow, x.ShardKey)
+
+		require.NoError(t, json.Unmarshal([]byte(`18446744073709551615`), &x))
+		assert.Equal(t, ShardKey(18446744073709551615), x.ShardKey, "unsigned values above the int64 range are kept")
+
+		require.NoError(t, x.Scan("18446744073709551615"))
+		assert.Equal(t, ShardKey(18446744073709551615), x.ShardKey)
+
+		require.NoError(t, json.Unmarshal([]byte(`null`), &x))
+		assert.False(t, x.Valid)
+
+		assert.Error(t, json.Unmarshal([]byte(`1.5`), &x))
+		assert.EqualError(t, x.UnmarshalJSON([]byte(`1 2`)), "invalid JSON for NullShardKey, unexpected data after the value")
+		assert.EqualError(t, x.UnmarshalJSON([]byte(`"primary"]`)), "invalid JSON for NullShardKey, unexpected data after the value")
+		require.NoError(t, x.UnmarshalJSON([]byte(" 1 \n")), "whitespace around the value is fine")
+		assert.Equal(t, ShardKeyPrimary, x.ShardKey)
+	})
+}
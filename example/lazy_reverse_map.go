@@ -0,0 +1,74 @@
+//go:generate ../bin/go-enum -f=$GOFILE --lazyreversemap --nocase --rawparse
+
+package example
+
+// Element is a large enumeration of chemical elements, which is mostly printed and rarely parsed.
+/*
+ENUM(
+hydrogen
+helium
+lithium
+beryllium
+boron
+carbon
+nitrogen
+oxygen
+fluorine
+neon
+sodium
+magnesium
+aluminium
+silicon
+phosphorus
+sulfur
+chlorine
+argon
+potassium
+calcium
+scandium
+titanium
+vanadium
+chromium
+manganese
+iron
+cobalt
+nickel
+copper
+zinc
+gallium
+germanium
+arsenic
+selenium
+bromine
+krypton
+rubidium
+strontium
+yttrium
+zirconium
+niobium
+molybdenum
+technetium
+ruthenium
+rhodium
+palladium
+silver
+cadmium
+indium
+tin
+antimony
+tellurium
+iodine
+xenon
+caesium
+barium
+lanthanum
+cerium
+praseodymium
+neodymium
+promethium
+samarium
+europium
+gadolinium
+)
+*/
+type Element int
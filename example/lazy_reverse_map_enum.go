@@ -0,0 +1,396 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Element is a large enumeration of chemical elements, which is mostly printed and rarely parsed.
+const (
+	// ElementHydrogen is a Element of type Hydrogen.
+	ElementHydrogen Element = iota
+	// ElementHelium is a Element of type Helium.
+	ElementHelium
+	// ElementLithium is a Element of type Lithium.
+	ElementLithium
+	// ElementBeryllium is a Element of type Beryllium.
+	ElementBeryllium
+	// ElementBoron is a Element of type Boron.
+	ElementBoron
+	// ElementCarbon is a Element of type Carbon.
+	ElementCarbon
+	// ElementNitrogen is a Element of type Nitrogen.
+	ElementNitrogen
+	// ElementOxygen is a Element of type Oxygen.
+	ElementOxygen
+	// ElementFluorine is a Element of type Fluorine.
+	ElementFluorine
+	// ElementNeon is a Element of type Neon.
+	ElementNeon
+	// ElementSodium is a Element of type Sodium.
+	ElementSodium
+	// ElementMagnesium is a Element of type Magnesium.
+	ElementMagnesium
+	// ElementAluminium is a Element of type Aluminium.
+	ElementAluminium
+	// ElementSilicon is a Element of type Silicon.
+	ElementSilicon
+	// ElementPhosphorus is a Element of type Phosphorus.
+	ElementPhosphorus
+	// ElementSulfur is a Element of type Sulfur.
+	ElementSulfur
+	// ElementChlorine is a Element of type Chlorine.
+	ElementChlorine
+	// ElementArgon is a Element of type Argon.
+	ElementArgon
+	// ElementPotassium is a Element of type Potassium.
+	ElementPotassium
+	// ElementCalcium is a Element of type Calcium.
+	ElementCalcium
+	// ElementScandium is a Element of type Scandium.
+	ElementScandium
+	// ElementTitanium is a Element of type Titanium.
+	ElementTitanium
+	// ElementVanadium is a Element of type Vanadium.
+	ElementVanadium
+	// ElementChromium is a Element of type Chromium.
+	ElementChromium
+	// ElementManganese is a Element of type Manganese.
+	ElementManganese
+	// ElementIron is a Element of type Iron.
+	ElementIron
+	// ElementCobalt is a Element of type Cobalt.
+	ElementCobalt
+	// ElementNickel is a Element of type Nickel.
+	ElementNickel
+	// ElementCopper is a Element of type Copper.
+	ElementCopper
+	// ElementZinc is a Element of type Zinc.
+	ElementZinc
+	// ElementGallium is a Element of type Gallium.
+	ElementGallium
+	// ElementGermanium is a Element of type Germanium.
+	ElementGermanium
+	// ElementArsenic is a Element of type Arsenic.
+	ElementArsenic
+	// ElementSelenium is a Element of type Selenium.
+	ElementSelenium
+	// ElementBromine is a Element of type Bromine.
+	ElementBromine
+	// ElementKrypton is a Element of type Krypton.
+	ElementKrypton
+	// ElementRubidium is a Element of type Rubidium.
+	ElementRubidium
+	// ElementStrontium is a Element of type Strontium.
+	ElementStrontium
+	// ElementYttrium is a Element of type Yttrium.
+	ElementYttrium
+	// ElementZirconium is a Element of type Zirconium.
+	ElementZirconium
+	// ElementNiobium is a Element of type Niobium.
+	ElementNiobium
+	// ElementMolybdenum is a Element of type Molybdenum.
+	ElementMolybdenum
+	// ElementTechnetium is a Element of type Technetium.
+	ElementTechnetium
+	// ElementRuthenium is a Element of type Ruthenium.
+	ElementRuthenium
+	// ElementRhodium is a Element of type Rhodium.
+	ElementRhodium
+	// ElementPalladium is a Element of type Palladium.
+	ElementPalladium
+	// ElementSilver is a Element of type Silver.
+	ElementSilver
+	// ElementCadmium is a Element of type Cadmium.
+	ElementCadmium
+	// ElementIndium is a Element of type Indium.
+	ElementIndium
+	// ElementTin is a Element of type Tin.
+	ElementTin
+	// ElementAntimony is a Element of type Antimony.
+	ElementAntimony
+	// ElementTellurium is a Element of type Tellurium.
+	ElementTellurium
+	// ElementIodine is a Element of type Iodine.
+	ElementIodine
+	// ElementXenon is a Element of type Xenon.
+	ElementXenon
+	// ElementCaesium is a Element of type Caesium.
+	ElementCaesium
+	// ElementBarium is a Element of type Barium.
+	ElementBarium
+	// ElementLanthanum is a Element of type Lanthanum.
+	ElementLanthanum
+	// ElementCerium is a Element of type Cerium.
+	ElementCerium
+	// ElementPraseodymium is a Element of type Praseodymium.
+	ElementPraseodymium
+	// ElementNeodymium is a Element of type Neodymium.
+	ElementNeodymium
+	// ElementPromethium is a Element of type Promethium.
+	ElementPromethium
+	// ElementSamarium is a Element of type Samarium.
+	ElementSamarium
+	// ElementEuropium is a Element of type Europium.
+	ElementEuropium
+	// ElementGadolinium is a Element of type Gadolinium.
+	ElementGadolinium
+)
+
+const _ElementName = "hydrogenheliumlithiumberylliumboroncarbonnitrogenoxygenfluorineneonsodiummagnesiumaluminiumsiliconphosphorussulfurchlorineargonpotassiumcalciumscandiumtitaniumvanadiumchromiummanganeseironcobaltnickelcopperzincgalliumgermaniumarsenicseleniumbrominekryptonrubidiumstrontiumyttriumzirconiumniobiummolybdenumtechnetiumrutheniumrhodiumpalladiumsilvercadmiumindiumtinantimonytelluriumiodinexenoncaesiumbariumlanthanumceriumpraseodymiumneodymiumpromethiumsamariumeuropiumgadolinium"
+
+var _ElementMap = map[Element]string{
+	ElementHydrogen:     _ElementName[0:8],
+	ElementHelium:       _ElementName[8:14],
+	ElementLithium:      _ElementName[14:21],
+	ElementBeryllium:    _ElementName[21:30],
+	ElementBoron:        _ElementName[30:35],
+	ElementCarbon:       _ElementName[35:41],
+	ElementNitrogen:     _ElementName[41:49],
+	ElementOxygen:       _ElementName[49:55],
+	ElementFluorine:     _ElementName[55:63],
+	ElementNeon:         _ElementName[63:67],
+	ElementSodium:       _ElementName[67:73],
+	ElementMagnesium:    _ElementName[73:82],
+	ElementAluminium:    _ElementName[82:91],
+	ElementSilicon:      _ElementName[91:98],
+	ElementPhosphorus:   _ElementName[98:108],
+	ElementSulfur:       _ElementName[108:114],
+	ElementChlorine:     _ElementName[114:122],
+	ElementArgon:        _ElementName[122:127],
+	ElementPotassium:    _ElementName[127:136],
+	ElementCalcium:      _ElementName[136:143],
+	ElementScandium:     _ElementName[143:151],
+	ElementTitanium:     _ElementName[151:159],
+	ElementVanadium:     _ElementName[159:167],
+	ElementChromium:     _ElementName[167:175],
+	ElementManganese:    _ElementName[175:184],
+	ElementIron:         _ElementName[184:188],
+	ElementCobalt:       _ElementName[188:194],
+	ElementNickel:       _ElementName[194:200],
+	ElementCopper:       _ElementName[200:206],
+	ElementZinc:         _ElementName[206:210],
+	ElementGallium:      _ElementName[210:217],
+	ElementGermanium:    _ElementName[217:226],
+	ElementArsenic:      _ElementName[226:233],
+	ElementSelenium:     _ElementName[233:241],
+	ElementBromine:      _ElementName[241:248],
+	ElementKrypton:      _ElementName[248:255],
+	ElementRubidium:     _ElementName[255:263],
+	ElementStrontium:    _ElementName[263:272],
+	ElementYttrium:      _ElementName[272:279],
+	ElementZirconium:    _ElementName[279:288],
+	ElementNiobium:      _ElementName[288:295],
+	ElementMolybdenum:   _ElementName[295:305],
+	ElementTechnetium:   _ElementName[305:315],
+	ElementRuthenium:    _ElementName[315:324],
+	ElementRhodium:      _ElementName[324:331],
+	ElementPalladium:    _ElementName[331:340],
+	ElementSilver:       _ElementName[340:346],
+	ElementCadmium:      _ElementName[346:353],
+	ElementIndium:       _ElementName[353:359],
+	ElementTin:          _ElementName[359:362],
+	ElementAntimony:     _ElementName[362:370],
+	ElementTellurium:    _ElementName[370:379],
+	ElementIodine:       _ElementName[379:385],
+	ElementXenon:        _ElementName[385:390],
+	ElementCaesium:      _ElementName[390:397],
+	ElementBarium:       _ElementName[397:403],
+	ElementLanthanum:    _ElementName[403:412],
+	ElementCerium:       _ElementName[412:418],
+	ElementPraseodymium: _ElementName[418:430],
+	ElementNeodymium:    _ElementName[430:439],
+	ElementPromethium:   _ElementName[439:449],
+	ElementSamarium:     _ElementName[449:457],
+	ElementEuropium:     _ElementName[457:465],
+	ElementGadolinium:   _ElementName[465:475],
+}
+
+// String implements the Stringer interface.
+func (x Element) String() string {
+	if str, ok := _ElementMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Element(%d)", x)
+}
+
+var (
+	_ElementValue     map[string]Element
+	_ElementValueOnce sync.Once
+)
+
+// _ElementBuildValueMap builds the name to value map used by parsing.
+func _ElementBuildValueMap() map[string]Element {
+	return map[string]Element{
+		_ElementName[0:8]:                      ElementHydrogen,
+		strings.ToLower(_ElementName[0:8]):     ElementHydrogen,
+		_ElementName[8:14]:                     ElementHelium,
+		strings.ToLower(_ElementName[8:14]):    ElementHelium,
+		_ElementName[14:21]:                    ElementLithium,
+		strings.ToLower(_ElementName[14:21]):   ElementLithium,
+		_ElementName[21:30]:                    ElementBeryllium,
+		strings.ToLower(_ElementName[21:30]):   ElementBeryllium,
+		_ElementName[30:35]:                    ElementBoron,
+		strings.ToLower(_ElementName[30:35]):   ElementBoron,
+		_ElementName[35:41]:                    ElementCarbon,
+		strings.ToLower(_ElementName[35:41]):   ElementCarbon,
+		_ElementName[41:49]:                    ElementNitrogen,
+		strings.ToLower(_ElementName[41:49]):   ElementNitrogen,
+		_ElementName[49:55]:                    ElementOxygen,
+		strings.ToLower(_ElementName[49:55]):   ElementOxygen,
+		_ElementName[55:63]:                    ElementFluorine,
+		strings.ToLower(_ElementName[55:63]):   ElementFluorine,
+		_ElementName[63:67]:                    ElementNeon,
+		strings.ToLower(_ElementName[63:67]):   ElementNeon,
+		_ElementName[67:73]:                    ElementSodium,
+		strings.ToLower(_ElementName[67:73]):   ElementSodium,
+		_ElementName[73:82]:                    ElementMagnesium,
+		strings.ToLower(_ElementName[73:82]):   ElementMagnesium,
+		_ElementName[82:91]:                    ElementAluminium,
+		strings.ToLower(_ElementName[82:91]):   ElementAluminium,
+		_ElementName[91:98]:                    ElementSilicon,
+		strings.ToLower(_ElementName[91:98]):   ElementSilicon,
+		_ElementName[98:108]:                   ElementPhosphorus,
+		strings.ToLower(_ElementName[98:108]):  ElementPhosphorus,
+		_ElementName[108:114]:                  ElementSulfur,
+		strings.ToLower(_ElementName[108:114]): ElementSulfur,
+		_ElementName[114:122]:                  ElementChlorine,
+		strings.ToLower(_ElementName[114:122]): ElementChlorine,
+		_ElementName[122:127]:                  ElementArgon,
+		strings.ToLower(_ElementName[122:127]): ElementArgon,
+		_ElementName[127:136]:                  ElementPotassium,
+		strings.ToLower(_ElementName[127:136]): ElementPotassium,
+		_ElementName[136:143]:                  ElementCalcium,
+		strings.ToLower(_ElementName[136:143]): ElementCalcium,
+		_ElementName[143:151]:                  ElementScandium,
+		strings.ToLower(_ElementName[143:151]): ElementScandium,
+		_ElementName[151:159]:                  ElementTitanium,
+		strings.ToLower(_ElementName[151:159]): ElementTitanium,
+		_ElementName[159:167]:                  ElementVanadium,
+		strings.ToLower(_ElementName[159:167]): ElementVanadium,
+		_ElementName[167:175]:                  ElementChromium,
+		strings.ToLower(_ElementName[167:175]): ElementChromium,
+		_ElementName[175:184]:                  ElementManganese,
+		strings.ToLower(_ElementName[175:184]): ElementManganese,
+		_ElementName[184:188]:                  ElementIron,
+		strings.ToLower(_ElementName[184:188]): ElementIron,
+		_ElementName[188:194]:                  ElementCobalt,
+		strings.ToLower(_ElementName[188:194]): ElementCobalt,
+		_ElementName[194:200]:                  ElementNickel,
+		strings.ToLower(_ElementName[194:200]): ElementNickel,
+		_ElementName[200:206]:                  ElementCopper,
+		strings.ToLower(_ElementName[200:206]): ElementCopper,
+		_ElementName[206:210]:                  ElementZinc,
+		strings.ToLower(_ElementName[206:210]): ElementZinc,
+		_ElementName[210:217]:                  ElementGallium,
+		strings.ToLower(_ElementName[210:217]): ElementGallium,
+		_ElementName[217:226]:                  ElementGermanium,
+		strings.ToLower(_ElementName[217:226]): ElementGermanium,
+		_ElementName[226:233]:                  ElementArsenic,
+		strings.ToLower(_ElementName[226:233]): ElementArsenic,
+		_ElementName[233:241]:                  ElementSelenium,
+		strings.ToLower(_ElementName[233:241]): ElementSelenium,
+		_ElementName[241:248]:                  ElementBromine,
+		strings.ToLower(_ElementName[241:248]): ElementBromine,
+		_ElementName[248:255]:                  ElementKrypton,
+		strings.ToLower(_ElementName[248:255]): ElementKrypton,
+		_ElementName[255:263]:                  ElementRubidium,
+		strings.ToLower(_ElementName[255:263]): ElementRubidium,
+		_ElementName[263:272]:                  ElementStrontium,
+		strings.ToLower(_ElementName[263:272]): ElementStrontium,
+		_ElementName[272:279]:                  ElementYttrium,
+		strings.ToLower(_ElementName[272:279]): ElementYttrium,
+		_ElementName[279:288]:                  ElementZirconium,
+		strings.ToLower(_ElementName[279:288]): ElementZirconium,
+		_ElementName[288:295]:                  ElementNiobium,
+		strings.ToLower(_ElementName[288:295]): ElementNiobium,
+		_ElementName[295:305]:                  ElementMolybdenum,
+		strings.ToLower(_ElementName[295:305]): ElementMolybdenum,
+		_ElementName[305:315]:                  ElementTechnetium,
+		strings.ToLower(_ElementName[305:315]): ElementTechnetium,
+		_ElementName[315:324]:                  ElementRuthenium,
+		strings.ToLower(_ElementName[315:324]): ElementRuthenium,
+		_ElementName[324:331]:                  ElementRhodium,
+		strings.ToLower(_ElementName[324:331]): ElementRhodium,
+		_ElementName[331:340]:                  ElementPalladium,
+		strings.ToLower(_ElementName[331:340]): ElementPalladium,
+		_ElementName[340:346]:                  ElementSilver,
+		strings.ToLower(_ElementName[340:346]): ElementSilver,
+		_ElementName[346:353]:                  ElementCadmium,
+		strings.ToLower(_ElementName[346:353]): ElementCadmium,
+		_ElementName[353:359]:                  ElementIndium,
+		strings.ToLower(_ElementName[353:359]): ElementIndium,
+		_ElementName[359:362]:                  ElementTin,
+		strings.ToLower(_ElementName[359:362]): ElementTin,
+		_ElementName[362:370]:                  ElementAntimony,
+		strings.ToLower(_ElementName[362:370]): ElementAntimony,
+		_ElementName[370:379]:                  ElementTellurium,
+		strings.ToLower(_ElementName[370:379]): ElementTellurium,
+		_ElementName[379:385]:                  ElementIodine,
+		strings.ToLower(_ElementName[379:385]): ElementIodine,
+		_ElementName[385:390]:                  ElementXenon,
+		strings.ToLower(_ElementName[385:390]): ElementXenon,
+		_ElementName[390:397]:                  ElementCaesium,
+		strings.ToLower(_ElementName[390:397]): ElementCaesium,
+		_ElementName[397:403]:                  ElementBarium,
+		strings.ToLower(_ElementName[397:403]): ElementBarium,
+		_ElementName[403:412]:                  ElementLanthanum,
+		strings.ToLower(_ElementName[403:412]): ElementLanthanum,
+		_ElementName[412:418]:                  ElementCerium,
+		strings.ToLower(_ElementName[412:418]): ElementCerium,
+		_ElementName[418:430]:                  ElementPraseodymium,
+		strings.ToLower(_ElementName[418:430]): ElementPraseodymium,
+		_ElementName[430:439]:                  ElementNeodymium,
+		strings.ToLower(_ElementName[430:439]): ElementNeodymium,
+		_ElementName[439:449]:                  ElementPromethium,
+		strings.ToLower(_ElementName[439:449]): ElementPromethium,
+		_ElementName[449:457]:                  ElementSamarium,
+		strings.ToLower(_ElementName[449:457]): ElementSamarium,
+		_ElementName[457:465]:                  ElementEuropium,
+		strings.ToLower(_ElementName[457:465]): ElementEuropium,
+		_ElementName[465:475]:                  ElementGadolinium,
+		strings.ToLower(_ElementName[465:475]): ElementGadolinium,
+	}
+}
+
+// _ElementValueMap returns the name to value map used by parsing, building it on first use.
+func _ElementValueMap() map[string]Element {
+	_ElementValueOnce.Do(func() {
+		_ElementValue = _ElementBuildValueMap()
+	})
+	return _ElementValue
+}
+
+// ParseElement attempts to convert a string to a Element.
+func ParseElement(name string) (Element, error) {
+	if x, ok := _ElementValueMap()[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ElementValueMap()[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Element(0), fmt.Errorf("%q is not a valid Element", name)
+}
+
+// ParseElementToken converts an already tokenized string to a Element, exactly like ParseElement.
+func ParseElementToken(tok string) (Element, error) {
+	return ParseElement(tok)
+}
+
+// ParseElementRaw looks the token up as is, without any normalization, and reports whether it is a valid Element.
+func ParseElementRaw(tok string) (Element, bool) {
+	x, ok := _ElementValueMap()[tok]
+	return x, ok
+}
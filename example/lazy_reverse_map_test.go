@@ -0,0 +1,48 @@
+package example
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestElementLazyReverseMap(t *testing.T) {
+	assert.Equal(t, "carbon", ElementCarbon.String())
+
+	x, err := ParseElement("Gadolinium")
+	require.NoError(t, err)
+	assert.Equal(t, ElementGadolinium, x)
+
+	x, ok := ParseElementRaw("tin")
+	assert.True(t, ok)
+	assert.Equal(t, ElementTin, x)
+
+	_, err = ParseElement("unobtainium")
+	assert.EqualError(t, err, "\"unobtainium\" is not a valid Element")
+}
+
+func BenchmarkElementReverseMap(b *testing.B) {
+	// The cost every program pays at initialization without the lazy option.
+	b.Run("build", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = _ElementBuildValueMap()
+		}
+	})
+	// The cost moved to the first parse with the lazy option.
+	b.Run("first parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ElementValueOnce = sync.Once{}
+			_, _ = ParseElement("gadolinium")
+		}
+	})
+	b.Run("parse", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = ParseElement("gadolinium")
+		}
+	})
+}
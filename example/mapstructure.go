@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --mapstructure
+
+package example
+
+// ENUM(debug, info, warn, error)
+type LogLevel int
@@ -0,0 +1,70 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"reflect"
+)
+
+const (
+	// LogLevelDebug is a LogLevel of type Debug.
+	LogLevelDebug LogLevel = iota
+	// LogLevelInfo is a LogLevel of type Info.
+	LogLevelInfo
+	// LogLevelWarn is a LogLevel of type Warn.
+	LogLevelWarn
+	// LogLevelError is a LogLevel of type Error.
+	LogLevelError
+)
+
+const _LogLevelName = "debuginfowarnerror"
+
+var _LogLevelMap = map[LogLevel]string{
+	LogLevelDebug: _LogLevelName[0:5],
+	LogLevelInfo:  _LogLevelName[5:9],
+	LogLevelWarn:  _LogLevelName[9:13],
+	LogLevelError: _LogLevelName[13:18],
+}
+
+// String implements the Stringer interface.
+func (x LogLevel) String() string {
+	if str, ok := _LogLevelMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("LogLevel(%d)", x)
+}
+
+var _LogLevelValue = map[string]LogLevel{
+	_LogLevelName[0:5]:   LogLevelDebug,
+	_LogLevelName[5:9]:   LogLevelInfo,
+	_LogLevelName[9:13]:  LogLevelWarn,
+	_LogLevelName[13:18]: LogLevelError,
+}
+
+// ParseLogLevel attempts to convert a string to a LogLevel.
+func ParseLogLevel(name string) (LogLevel, error) {
+	if x, ok := _LogLevelValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return LogLevel(0), fmt.Errorf("%q is not a valid LogLevel", name)
+}
+
+// LogLevelDecodeHook returns a decode hook matching the github.com/mitchellh/mapstructure
+// DecodeHookFuncType signature that converts strings into LogLevel values.
+func LogLevelDecodeHook() func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+		if from.Kind() != reflect.String || to != reflect.TypeOf(LogLevel(0)) {
+			return data, nil
+		}
+		return ParseLogLevel(reflect.ValueOf(data).String())
+	}
+}
@@ -0,0 +1,38 @@
+package example
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogLevelDecodeHook(t *testing.T) {
+	hook := LogLevelDecodeHook()
+	stringType := reflect.TypeOf("")
+	levelType := reflect.TypeOf(LogLevel(0))
+
+	t.Run("string to enum", func(t *testing.T) {
+		out, err := hook(stringType, levelType, "warn")
+		require.NoError(t, err)
+		assert.Equal(t, LogLevelWarn, out)
+	})
+
+	t.Run("invalid string", func(t *testing.T) {
+		_, err := hook(stringType, levelType, "verbose")
+		assert.EqualError(t, err, "\"verbose\" is not a valid LogLevel")
+	})
+
+	t.Run("other target type", func(t *testing.T) {
+		out, err := hook(stringType, stringType, "warn")
+		require.NoError(t, err)
+		assert.Equal(t, "warn", out)
+	})
+
+	t.Run("non string source", func(t *testing.T) {
+		out, err := hook(reflect.TypeOf(0), levelType, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 2, out)
+	})
+}
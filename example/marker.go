@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marker
+
+package example
+
+// ENUM(debit, credit)
+type LedgerSide int
@@ -0,0 +1,59 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// LedgerSideDebit is a LedgerSide of type Debit.
+	LedgerSideDebit LedgerSide = iota
+	// LedgerSideCredit is a LedgerSide of type Credit.
+	LedgerSideCredit
+)
+
+const _LedgerSideName = "debitcredit"
+
+var _LedgerSideMap = map[LedgerSide]string{
+	LedgerSideDebit:  _LedgerSideName[0:5],
+	LedgerSideCredit: _LedgerSideName[5:11],
+}
+
+// String implements the Stringer interface.
+func (x LedgerSide) String() string {
+	if str, ok := _LedgerSideMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("LedgerSide(%d)", x)
+}
+
+var _LedgerSideValue = map[string]LedgerSide{
+	_LedgerSideName[0:5]:  LedgerSideDebit,
+	_LedgerSideName[5:11]: LedgerSideCredit,
+}
+
+// ParseLedgerSide attempts to convert a string to a LedgerSide.
+func ParseLedgerSide(name string) (LedgerSide, error) {
+	if x, ok := _LedgerSideValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return LedgerSide(0), fmt.Errorf("%q is not a valid LedgerSide", name)
+}
+
+// The same method set as goenum.Enum, which is checked without importing it so the generated code only needs the standard library.
+var _ interface {
+	String() string
+	GoEnum()
+} = LedgerSide(0)
+
+// GoEnum marks LedgerSide as a generated enum, implementing goenum.Enum.
+func (LedgerSide) GoEnum() {}
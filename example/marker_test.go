@@ -0,0 +1,20 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/abice/go-enum/goenum"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLedgerSideMarkerInterface(t *testing.T) {
+	var x interface{} = LedgerSideCredit
+	e, ok := x.(goenum.Enum)
+	assert.True(t, ok, "LedgerSide should implement goenum.Enum")
+	assert.Equal(t, "credit", e.String())
+
+	// Enums generated without the option don't.
+	x = ShirtSizeSmall
+	_, ok = x.(goenum.Enum)
+	assert.False(t, ok)
+}
@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --metriclabels
+
+package example
+
+// ENUM(Queued, InProgress, waiting-on-review, HTTP2Upgrade, done)
+type TaskState int
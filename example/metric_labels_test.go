@@ -0,0 +1,30 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStateLabelValue(t *testing.T) {
+	tests := map[TaskState]string{
+		TaskStateQueued:          "queued",
+		TaskStateInProgress:      "in_progress",
+		TaskStateWaitingOnReview: "waiting_on_review",
+		TaskStateHTTP2Upgrade:    "http2_upgrade",
+		TaskStateDone:            "done",
+	}
+	for x, label := range tests {
+		assert.Equal(t, label, x.LabelValue())
+
+		parsed, err := ParseTaskStateLabelValue(label)
+		require.NoError(t, err)
+		assert.Equal(t, x, parsed)
+	}
+
+	assert.Equal(t, "unknown", TaskState(42).LabelValue())
+
+	_, err := ParseTaskStateLabelValue("InProgress")
+	assert.EqualError(t, err, "InProgress is not a valid TaskState label value")
+}
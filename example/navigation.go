@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --navigation
+
+package example
+
+// WizardStep is a step of the signup wizard, numbered with room for steps to be added later.
+// ENUM(account=10, profile=20, billing=40, confirm=50)
+type WizardStep int
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --navigationclamp
+
+package example
+
+// Zoom is a zoom level of the map view, which stops at the closest and farthest levels.
+// ENUM(world, country, city=5, street)
+type Zoom int
@@ -0,0 +1,93 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// WizardStep is a step of the signup wizard, numbered with room for steps to be added later.
+const (
+	// WizardStepAccount is a WizardStep of type Account.
+	WizardStepAccount WizardStep = iota + 10
+	// WizardStepProfile is a WizardStep of type Profile.
+	WizardStepProfile WizardStep = iota + 19
+	// WizardStepBilling is a WizardStep of type Billing.
+	WizardStepBilling WizardStep = iota + 38
+	// WizardStepConfirm is a WizardStep of type Confirm.
+	WizardStepConfirm WizardStep = iota + 47
+)
+
+const _WizardStepName = "accountprofilebillingconfirm"
+
+var _WizardStepMap = map[WizardStep]string{
+	WizardStepAccount: _WizardStepName[0:7],
+	WizardStepProfile: _WizardStepName[7:14],
+	WizardStepBilling: _WizardStepName[14:21],
+	WizardStepConfirm: _WizardStepName[21:28],
+}
+
+// String implements the Stringer interface.
+func (x WizardStep) String() string {
+	if str, ok := _WizardStepMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("WizardStep(%d)", x)
+}
+
+var _WizardStepValue = map[string]WizardStep{
+	_WizardStepName[0:7]:   WizardStepAccount,
+	_WizardStepName[7:14]:  WizardStepProfile,
+	_WizardStepName[14:21]: WizardStepBilling,
+	_WizardStepName[21:28]: WizardStepConfirm,
+}
+
+// ParseWizardStep attempts to convert a string to a WizardStep.
+func ParseWizardStep(name string) (WizardStep, error) {
+	if x, ok := _WizardStepValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return WizardStep(0), fmt.Errorf("%q is not a valid WizardStep", name)
+}
+
+var _WizardStepNavigation = []WizardStep{
+	WizardStepAccount,
+	WizardStepProfile,
+	WizardStepBilling,
+	WizardStepConfirm,
+}
+
+var _WizardStepNavigationIndex = map[WizardStep]int{
+	WizardStepAccount: 0,
+	WizardStepProfile: 1,
+	WizardStepBilling: 2,
+	WizardStepConfirm: 3,
+}
+
+// Next returns the WizardStep declared after x, wrapping around to the first one after the last one.
+// Undefined values are returned unchanged.
+func (x WizardStep) Next() WizardStep {
+	i, ok := _WizardStepNavigationIndex[x]
+	if !ok {
+		return x
+	}
+	return _WizardStepNavigation[(i+1)%len(_WizardStepNavigation)]
+}
+
+// Prev returns the WizardStep declared before x, wrapping around to the last one before the first one.
+// Undefined values are returned unchanged.
+func (x WizardStep) Prev() WizardStep {
+	i, ok := _WizardStepNavigationIndex[x]
+	if !ok {
+		return x
+	}
+	return _WizardStepNavigation[(i+len(_WizardStepNavigation)-1)%len(_WizardStepNavigation)]
+}
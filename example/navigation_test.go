@@ -0,0 +1,43 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWizardStepNavigation(t *testing.T) {
+	tests := map[WizardStep]struct {
+		next WizardStep
+		prev WizardStep
+	}{
+		WizardStepAccount: {next: WizardStepProfile, prev: WizardStepConfirm},
+		WizardStepProfile: {next: WizardStepBilling, prev: WizardStepAccount},
+		WizardStepBilling: {next: WizardStepConfirm, prev: WizardStepProfile},
+		WizardStepConfirm: {next: WizardStepAccount, prev: WizardStepBilling},
+		WizardStep(30):    {next: WizardStep(30), prev: WizardStep(30)},
+	}
+
+	for x, tc := range tests {
+		assert.Equal(t, tc.next, x.Next(), "next of %s", x)
+		assert.Equal(t, tc.prev, x.Prev(), "prev of %s", x)
+	}
+}
+
+func TestZoomNavigation(t *testing.T) {
+	tests := map[Zoom]struct {
+		next Zoom
+		prev Zoom
+	}{
+		ZoomWorld:   {next: ZoomCountry, prev: ZoomWorld},
+		ZoomCountry: {next: ZoomCity, prev: ZoomWorld},
+		ZoomCity:    {next: ZoomStreet, prev: ZoomCountry},
+		ZoomStreet:  {next: ZoomStreet, prev: ZoomCity},
+		Zoom(2):     {next: Zoom(2), prev: Zoom(2)},
+	}
+
+	for x, tc := range tests {
+		assert.Equal(t, tc.next, x.Next(), "next of %s", x)
+		assert.Equal(t, tc.prev, x.Prev(), "prev of %s", x)
+	}
+}
@@ -59,7 +59,11 @@ func ParseAllNegative(name string) (AllNegative, error) {
 	if x, ok := _AllNegativeValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return AllNegative(0), fmt.Errorf("%s is not a valid AllNegative", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return AllNegative(0), fmt.Errorf("%q is not a valid AllNegative", name)
 }
 
 const (
@@ -105,5 +109,9 @@ func ParseStatus(name string) (Status, error) {
 	if x, ok := _StatusValue[strings.ToLower(name)]; ok {
 		return x, nil
 	}
-	return Status(0), fmt.Errorf("%s is not a valid Status", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Status(0), fmt.Errorf("%q is not a valid Status", name)
 }
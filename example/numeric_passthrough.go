@@ -0,0 +1,10 @@
+//go:generate ../bin/go-enum -f=$GOFILE --numericpassthrough
+
+package example
+
+// EventType is an enumeration of event types, where newer producers may send types this version doesn't know about.
+// ENUM(created, updated, deleted)
+type EventType int
+
+// ENUM(read, write=4)
+type Permission uint8
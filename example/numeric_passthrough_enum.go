@@ -0,0 +1,160 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// EventType is an enumeration of event types, where newer producers may send types this version doesn't know about.
+const (
+	// EventTypeCreated is a EventType of type Created.
+	EventTypeCreated EventType = iota
+	// EventTypeUpdated is a EventType of type Updated.
+	EventTypeUpdated
+	// EventTypeDeleted is a EventType of type Deleted.
+	EventTypeDeleted
+)
+
+const _EventTypeName = "createdupdateddeleted"
+
+var _EventTypeMap = map[EventType]string{
+	EventTypeCreated: _EventTypeName[0:7],
+	EventTypeUpdated: _EventTypeName[7:14],
+	EventTypeDeleted: _EventTypeName[14:21],
+}
+
+// String implements the Stringer interface.
+func (x EventType) String() string {
+	if str, ok := _EventTypeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("EventType(%d)", x)
+}
+
+var _EventTypeValue = map[string]EventType{
+	_EventTypeName[0:7]:   EventTypeCreated,
+	_EventTypeName[7:14]:  EventTypeUpdated,
+	_EventTypeName[14:21]: EventTypeDeleted,
+}
+
+// ParseEventType attempts to convert a string to a EventType.
+func ParseEventType(name string) (EventType, error) {
+	if x, ok := _EventTypeValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return EventType(0), fmt.Errorf("%q is not a valid EventType", name)
+}
+
+// MarshalJSON implements the json marshaller method.
+// Undefined values are written as their integer, so they are preserved through a round-trip.
+func (x EventType) MarshalJSON() ([]byte, error) {
+	if _, ok := _EventTypeMap[x]; !ok {
+		return []byte(strconv.FormatInt(int64(x), 10)), nil
+	}
+	return json.Marshal(x.String())
+}
+
+// UnmarshalJSON implements the json unmarshaller method.
+// Integers are stored as is, even if they are not a defined EventType.
+func (x *EventType) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] != '"' {
+		val, err := strconv.ParseInt(string(b), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid EventType: %w", b, err)
+		}
+		*x = EventType(val)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		return err
+	}
+	tmp, err := ParseEventType(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+const (
+	// PermissionRead is a Permission of type Read.
+	PermissionRead Permission = iota
+	// PermissionWrite is a Permission of type Write.
+	PermissionWrite Permission = iota + 3
+)
+
+const _PermissionName = "readwrite"
+
+var _PermissionMap = map[Permission]string{
+	PermissionRead:  _PermissionName[0:4],
+	PermissionWrite: _PermissionName[4:9],
+}
+
+// String implements the Stringer interface.
+func (x Permission) String() string {
+	if str, ok := _PermissionMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Permission(%d)", x)
+}
+
+var _PermissionValue = map[string]Permission{
+	_PermissionName[0:4]: PermissionRead,
+	_PermissionName[4:9]: PermissionWrite,
+}
+
+// ParsePermission attempts to convert a string to a Permission.
+func ParsePermission(name string) (Permission, error) {
+	if x, ok := _PermissionValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Permission(0), fmt.Errorf("%q is not a valid Permission", name)
+}
+
+// MarshalJSON implements the json marshaller method.
+// Undefined values are written as their integer, so they are preserved through a round-trip.
+func (x Permission) MarshalJSON() ([]byte, error) {
+	if _, ok := _PermissionMap[x]; !ok {
+		return []byte(strconv.FormatUint(uint64(x), 10)), nil
+	}
+	return json.Marshal(x.String())
+}
+
+// UnmarshalJSON implements the json unmarshaller method.
+// Integers are stored as is, even if they are not a defined Permission.
+func (x *Permission) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] != '"' {
+		val, err := strconv.ParseUint(string(b), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid Permission: %w", b, err)
+		}
+		*x = Permission(val)
+		return nil
+	}
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		return err
+	}
+	tmp, err := ParsePermission(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,64 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type eventEnvelope struct {
+	Type       EventType  `json:"type"`
+	Permission Permission `json:"permission"`
+}
+
+func TestEventTypeNumericPassthrough(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected eventEnvelope
+		output   string
+	}{
+		"names": {
+			input:    `{"type":"updated","permission":"write"}`,
+			expected: eventEnvelope{Type: EventTypeUpdated, Permission: PermissionWrite},
+			output:   `{"type":"updated","permission":"write"}`,
+		},
+		"defined integers": {
+			input:    `{"type":2,"permission":4}`,
+			expected: eventEnvelope{Type: EventTypeDeleted, Permission: PermissionWrite},
+			output:   `{"type":"deleted","permission":"write"}`,
+		},
+		"unknown integers": {
+			input:    `{"type":42,"permission":200}`,
+			expected: eventEnvelope{Type: EventType(42), Permission: Permission(200)},
+			output:   `{"type":42,"permission":200}`,
+		},
+		"negative unknown integer": {
+			input:    `{"type":-7,"permission":0}`,
+			expected: eventEnvelope{Type: EventType(-7), Permission: PermissionRead},
+			output:   `{"type":-7,"permission":"read"}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var env eventEnvelope
+			require.NoError(t, json.Unmarshal([]byte(tc.input), &env))
+			assert.Equal(t, tc.expected, env)
+
+			b, err := json.Marshal(env)
+			require.NoError(t, err)
+			assert.Equal(t, tc.output, string(b))
+		})
+	}
+}
+
+func TestEventTypeNumericPassthroughErrors(t *testing.T) {
+	var x EventType
+	assert.EqualError(t, json.Unmarshal([]byte(`"archived"`), &x), "\"archived\" is not a valid EventType")
+	assert.Error(t, json.Unmarshal([]byte(`1.5`), &x))
+
+	var p Permission
+	assert.Error(t, json.Unmarshal([]byte(`-1`), &p))
+}
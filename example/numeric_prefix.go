@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --noprefix --numericprefix=Class
+
+package example
+
+// StatusClass is the class of an HTTP status code.
+// ENUM(1xx, 2xx, 3xx, 4xx, 5xx)
+type StatusClass int
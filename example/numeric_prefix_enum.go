@@ -0,0 +1,63 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// StatusClass is the class of an HTTP status code.
+const (
+	// Class1xx is a StatusClass of type 1xx.
+	Class1xx StatusClass = iota
+	// Class2xx is a StatusClass of type 2xx.
+	Class2xx
+	// Class3xx is a StatusClass of type 3xx.
+	Class3xx
+	// Class4xx is a StatusClass of type 4xx.
+	Class4xx
+	// Class5xx is a StatusClass of type 5xx.
+	Class5xx
+)
+
+const _StatusClassName = "1xx2xx3xx4xx5xx"
+
+var _StatusClassMap = map[StatusClass]string{
+	Class1xx: _StatusClassName[0:3],
+	Class2xx: _StatusClassName[3:6],
+	Class3xx: _StatusClassName[6:9],
+	Class4xx: _StatusClassName[9:12],
+	Class5xx: _StatusClassName[12:15],
+}
+
+// String implements the Stringer interface.
+func (x StatusClass) String() string {
+	if str, ok := _StatusClassMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("StatusClass(%d)", x)
+}
+
+var _StatusClassValue = map[string]StatusClass{
+	_StatusClassName[0:3]:   Class1xx,
+	_StatusClassName[3:6]:   Class2xx,
+	_StatusClassName[6:9]:   Class3xx,
+	_StatusClassName[9:12]:  Class4xx,
+	_StatusClassName[12:15]: Class5xx,
+}
+
+// ParseStatusClass attempts to convert a string to a StatusClass.
+func ParseStatusClass(name string) (StatusClass, error) {
+	if x, ok := _StatusClassValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return StatusClass(0), fmt.Errorf("%q is not a valid StatusClass", name)
+}
@@ -0,0 +1,28 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusClassNumericPrefix(t *testing.T) {
+	tests := map[string]StatusClass{
+		"1xx": Class1xx,
+		"2xx": Class2xx,
+		"3xx": Class3xx,
+		"4xx": Class4xx,
+		"5xx": Class5xx,
+	}
+
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, name, value.String())
+
+			parsed, err := ParseStatusClass(name)
+			require.NoError(t, err)
+			assert.Equal(t, value, parsed)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --ordinal
+
+package example
+
+// Sparse is an enumeration with gaps between its values.
+// ENUM(first=1, _, third, tenth=10, twentieth=20)
+type Sparse int
@@ -0,0 +1,103 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Sparse is an enumeration with gaps between its values.
+const (
+	// SparseFirst is a Sparse of type First.
+	SparseFirst Sparse = iota + 1
+	// Skipped value.
+	_
+	// SparseThird is a Sparse of type Third.
+	SparseThird
+	// SparseTenth is a Sparse of type Tenth.
+	SparseTenth Sparse = iota + 7
+	// SparseTwentieth is a Sparse of type Twentieth.
+	SparseTwentieth Sparse = iota + 16
+)
+
+const _SparseName = "firstthirdtenthtwentieth"
+
+var _SparseMap = map[Sparse]string{
+	SparseFirst:     _SparseName[0:5],
+	SparseThird:     _SparseName[5:10],
+	SparseTenth:     _SparseName[10:15],
+	SparseTwentieth: _SparseName[15:24],
+}
+
+// String implements the Stringer interface.
+func (x Sparse) String() string {
+	if str, ok := _SparseMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Sparse(%d)", x)
+}
+
+var _SparseValue = map[string]Sparse{
+	_SparseName[0:5]:   SparseFirst,
+	_SparseName[5:10]:  SparseThird,
+	_SparseName[10:15]: SparseTenth,
+	_SparseName[15:24]: SparseTwentieth,
+}
+
+// ParseSparse attempts to convert a string to a Sparse.
+func ParseSparse(name string) (Sparse, error) {
+	if x, ok := _SparseValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Sparse(0), fmt.Errorf("%q is not a valid Sparse", name)
+}
+
+var _SparseOrdinals = []Sparse{
+	SparseFirst,
+	SparseThird,
+	SparseTenth,
+	SparseTwentieth,
+}
+
+var _SparseOrdinalMap = map[Sparse]int{
+	SparseFirst:     0,
+	SparseThird:     1,
+	SparseTenth:     2,
+	SparseTwentieth: 3,
+}
+
+// SparseValueToOrdinal returns the declaration order index of the Sparse, and whether it is a defined value.
+func SparseValueToOrdinal(v Sparse) (int, bool) {
+	i, ok := _SparseOrdinalMap[v]
+	return i, ok
+}
+
+// SparseOrdinalToValue returns the Sparse at the given declaration order index, and whether the index is in range.
+func SparseOrdinalToValue(i int) (Sparse, bool) {
+	if i < 0 || i >= len(_SparseOrdinals) {
+		return Sparse(0), false
+	}
+	return _SparseOrdinals[i], true
+}
+
+// SparseStepsBetween returns how many declaration order steps b is after a, which is negative when b comes first.
+// An error is returned if either of them is not a defined Sparse.
+func SparseStepsBetween(a, b Sparse) (int, error) {
+	from, ok := _SparseOrdinalMap[a]
+	if !ok {
+		return 0, fmt.Errorf("%v is not a defined Sparse", a)
+	}
+	to, ok := _SparseOrdinalMap[b]
+	if !ok {
+		return 0, fmt.Errorf("%v is not a defined Sparse", b)
+	}
+	return to - from, nil
+}
@@ -0,0 +1,66 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparseOrdinal(t *testing.T) {
+	tests := map[string]struct {
+		value   Sparse
+		ordinal int
+	}{
+		"first":     {value: SparseFirst, ordinal: 0},
+		"third":     {value: SparseThird, ordinal: 1},
+		"tenth":     {value: SparseTenth, ordinal: 2},
+		"twentieth": {value: SparseTwentieth, ordinal: 3},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ordinal, ok := SparseValueToOrdinal(tc.value)
+			assert.True(t, ok)
+			assert.Equal(t, tc.ordinal, ordinal)
+
+			value, ok := SparseOrdinalToValue(tc.ordinal)
+			assert.True(t, ok)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+
+	t.Run("out of range", func(t *testing.T) {
+		_, ok := SparseValueToOrdinal(Sparse(2))
+		assert.False(t, ok)
+		_, ok = SparseOrdinalToValue(-1)
+		assert.False(t, ok)
+		_, ok = SparseOrdinalToValue(4)
+		assert.False(t, ok)
+	})
+}
+
+func TestSparseStepsBetween(t *testing.T) {
+	tests := map[string]struct {
+		a, b  Sparse
+		steps int
+		err   string
+	}{
+		"forward":         {a: SparseFirst, b: SparseTwentieth, steps: 3},
+		"backward":        {a: SparseTenth, b: SparseThird, steps: -1},
+		"same":            {a: SparseThird, b: SparseThird, steps: 0},
+		"undefined":       {a: SparseFirst, b: Sparse(2), err: "Sparse(2) is not a defined Sparse"},
+		"undefined start": {a: Sparse(15), b: SparseFirst, err: "Sparse(15) is not a defined Sparse"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			steps, err := SparseStepsBetween(tc.a, tc.b)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.steps, steps)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum -f=$GOFILE --parseordefault --nocase
+
+package example
+
+// Theme is the color theme picked in the user settings.
+// ENUM(system, light, dark)
+type Theme int
+
+// Locale is the language picked in the user settings.
+// ENUM(en, nl, de)
+type Locale string
@@ -0,0 +1,121 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Locale is the language picked in the user settings.
+const (
+	// LocaleEn is a Locale of type En.
+	LocaleEn Locale = "en"
+	// LocaleNl is a Locale of type Nl.
+	LocaleNl Locale = "nl"
+	// LocaleDe is a Locale of type De.
+	LocaleDe Locale = "de"
+)
+
+const _LocaleName = "ennlde"
+
+// String implements the Stringer interface.
+func (x Locale) String() string {
+	return string(x)
+}
+
+var _LocaleValue = map[string]Locale{
+	_LocaleName[0:2]:                  LocaleEn,
+	strings.ToLower(_LocaleName[0:2]): LocaleEn,
+	_LocaleName[2:4]:                  LocaleNl,
+	strings.ToLower(_LocaleName[2:4]): LocaleNl,
+	_LocaleName[4:6]:                  LocaleDe,
+	strings.ToLower(_LocaleName[4:6]): LocaleDe,
+}
+
+// ParseLocale attempts to convert a string to a Locale.
+func ParseLocale(name string) (Locale, error) {
+	if x, ok := _LocaleValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _LocaleValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Locale(""), fmt.Errorf("%q is not a valid Locale", name)
+}
+
+// ParseLocaleOrDefault converts a string to a Locale, and returns def if it is not valid.
+func ParseLocaleOrDefault(name string, def Locale) Locale {
+	if val, err := ParseLocale(name); err == nil {
+		return val
+	}
+	return def
+}
+
+// Theme is the color theme picked in the user settings.
+const (
+	// ThemeSystem is a Theme of type System.
+	ThemeSystem Theme = iota
+	// ThemeLight is a Theme of type Light.
+	ThemeLight
+	// ThemeDark is a Theme of type Dark.
+	ThemeDark
+)
+
+const _ThemeName = "systemlightdark"
+
+var _ThemeMap = map[Theme]string{
+	ThemeSystem: _ThemeName[0:6],
+	ThemeLight:  _ThemeName[6:11],
+	ThemeDark:   _ThemeName[11:15],
+}
+
+// String implements the Stringer interface.
+func (x Theme) String() string {
+	if str, ok := _ThemeMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Theme(%d)", x)
+}
+
+var _ThemeValue = map[string]Theme{
+	_ThemeName[0:6]:                    ThemeSystem,
+	strings.ToLower(_ThemeName[0:6]):   ThemeSystem,
+	_ThemeName[6:11]:                   ThemeLight,
+	strings.ToLower(_ThemeName[6:11]):  ThemeLight,
+	_ThemeName[11:15]:                  ThemeDark,
+	strings.ToLower(_ThemeName[11:15]): ThemeDark,
+}
+
+// ParseTheme attempts to convert a string to a Theme.
+func ParseTheme(name string) (Theme, error) {
+	if x, ok := _ThemeValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _ThemeValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Theme(0), fmt.Errorf("%q is not a valid Theme", name)
+}
+
+// ParseThemeOrDefault converts a string to a Theme, and returns def if it is not valid.
+func ParseThemeOrDefault(name string, def Theme) Theme {
+	if val, err := ParseTheme(name); err == nil {
+		return val
+	}
+	return def
+}
@@ -0,0 +1,17 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOrDefault(t *testing.T) {
+	assert.Equal(t, ThemeDark, ParseThemeOrDefault("dark", ThemeSystem))
+	assert.Equal(t, ThemeLight, ParseThemeOrDefault("LIGHT", ThemeSystem))
+	assert.Equal(t, ThemeSystem, ParseThemeOrDefault("solarized", ThemeSystem))
+	assert.Equal(t, ThemeLight, ParseThemeOrDefault("", ThemeLight))
+
+	assert.Equal(t, LocaleNl, ParseLocaleOrDefault("nl", LocaleEn))
+	assert.Equal(t, LocaleEn, ParseLocaleOrDefault("fr", LocaleEn))
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --pgx
+
+package example
+
+// Shipping is the delivery method of an order, stored in a postgres enum column.
+// ENUM(standard, express, overnight)
+type Shipping int
@@ -0,0 +1,90 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Shipping is the delivery method of an order, stored in a postgres enum column.
+const (
+	// ShippingStandard is a Shipping of type Standard.
+	ShippingStandard Shipping = iota
+	// ShippingExpress is a Shipping of type Express.
+	ShippingExpress
+	// ShippingOvernight is a Shipping of type Overnight.
+	ShippingOvernight
+)
+
+const _ShippingName = "standardexpressovernight"
+
+var _ShippingMap = map[Shipping]string{
+	ShippingStandard:  _ShippingName[0:8],
+	ShippingExpress:   _ShippingName[8:15],
+	ShippingOvernight: _ShippingName[15:24],
+}
+
+// String implements the Stringer interface.
+func (x Shipping) String() string {
+	if str, ok := _ShippingMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Shipping(%d)", x)
+}
+
+var _ShippingValue = map[string]Shipping{
+	_ShippingName[0:8]:   ShippingStandard,
+	_ShippingName[8:15]:  ShippingExpress,
+	_ShippingName[15:24]: ShippingOvernight,
+}
+
+// ParseShipping attempts to convert a string to a Shipping.
+func ParseShipping(name string) (Shipping, error) {
+	if x, ok := _ShippingValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Shipping(0), fmt.Errorf("%q is not a valid Shipping", name)
+}
+
+var _ShippingPgxValue = map[string]Shipping{
+	"express":   ShippingExpress,
+	"overnight": ShippingOvernight,
+	"standard":  ShippingStandard,
+}
+
+// TextValue implements the pgtype.TextValuer interface, so pgx stores the Shipping as its string form.
+func (x Shipping) TextValue() (pgtype.Text, error) {
+	str, ok := _ShippingMap[x]
+	if !ok {
+		return pgtype.Text{}, fmt.Errorf("%d is not a valid Shipping", x)
+	}
+	return pgtype.Text{String: str, Valid: true}, nil
+}
+
+// ScanText implements the pgtype.TextScanner interface, matching the string form case insensitively.
+// A NULL can not be scanned into a Shipping.
+func (x *Shipping) ScanText(v pgtype.Text) error {
+	if !v.Valid {
+		return fmt.Errorf("cannot scan NULL into Shipping")
+	}
+	if tmp, err := ParseShipping(v.String); err == nil {
+		*x = tmp
+		return nil
+	}
+	if tmp, ok := _ShippingPgxValue[strings.ToLower(v.String)]; ok {
+		*x = tmp
+		return nil
+	}
+	return fmt.Errorf("%s is not a valid Shipping", v.String)
+}
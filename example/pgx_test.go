@@ -0,0 +1,64 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ pgtype.TextValuer  = Shipping(0)
+	_ pgtype.TextScanner = (*Shipping)(nil)
+)
+
+func TestShippingPgx(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		v, err := ShippingOvernight.TextValue()
+		require.NoError(t, err)
+		assert.Equal(t, pgtype.Text{String: "overnight", Valid: true}, v)
+
+		_, err = Shipping(9).TextValue()
+		assert.EqualError(t, err, "9 is not a valid Shipping")
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		tests := map[string]Shipping{
+			"express":  ShippingExpress,
+			"Express":  ShippingExpress,
+			"STANDARD": ShippingStandard,
+		}
+		for input, expected := range tests {
+			var x Shipping
+			require.NoError(t, x.ScanText(pgtype.Text{String: input, Valid: true}), input)
+			assert.Equal(t, expected, x, input)
+		}
+
+		var x Shipping
+		assert.EqualError(t, x.ScanText(pgtype.Text{String: "drone", Valid: true}), "drone is not a valid Shipping")
+		assert.EqualError(t, x.ScanText(pgtype.Text{}), "cannot scan NULL into Shipping")
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, x := range []Shipping{ShippingStandard, ShippingExpress, ShippingOvernight} {
+			v, err := x.TextValue()
+			require.NoError(t, err)
+
+			var scanned Shipping
+			require.NoError(t, scanned.ScanText(v))
+			assert.Equal(t, x, scanned)
+		}
+	})
+
+	t.Run("codec", func(t *testing.T) {
+		m := pgtype.NewMap()
+		buf, err := m.Encode(pgtype.TextOID, pgtype.TextFormatCode, ShippingExpress, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "express", string(buf))
+
+		var x Shipping
+		require.NoError(t, m.Scan(pgtype.TextOID, pgtype.TextFormatCode, []byte("Overnight"), &x))
+		assert.Equal(t, ShippingOvernight, x)
+	})
+}
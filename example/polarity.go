@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal
+
+package example
+
+// Polarity is the polarity of a reading, counting on from a negative base.
+// ENUM(unknown=-2, negative, neutral, positive)
+type Polarity int
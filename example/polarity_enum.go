@@ -0,0 +1,75 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Polarity is the polarity of a reading, counting on from a negative base.
+const (
+	// PolarityUnknown is a Polarity of type Unknown.
+	PolarityUnknown Polarity = iota + -2
+	// PolarityNegative is a Polarity of type Negative.
+	PolarityNegative
+	// PolarityNeutral is a Polarity of type Neutral.
+	PolarityNeutral
+	// PolarityPositive is a Polarity of type Positive.
+	PolarityPositive
+)
+
+const _PolarityName = "unknownnegativeneutralpositive"
+
+var _PolarityMap = map[Polarity]string{
+	PolarityUnknown:  _PolarityName[0:7],
+	PolarityNegative: _PolarityName[7:15],
+	PolarityNeutral:  _PolarityName[15:22],
+	PolarityPositive: _PolarityName[22:30],
+}
+
+// String implements the Stringer interface.
+func (x Polarity) String() string {
+	if str, ok := _PolarityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Polarity(%d)", x)
+}
+
+var _PolarityValue = map[string]Polarity{
+	_PolarityName[0:7]:   PolarityUnknown,
+	_PolarityName[7:15]:  PolarityNegative,
+	_PolarityName[15:22]: PolarityNeutral,
+	_PolarityName[22:30]: PolarityPositive,
+}
+
+// ParsePolarity attempts to convert a string to a Polarity.
+func ParsePolarity(name string) (Polarity, error) {
+	if x, ok := _PolarityValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Polarity(0), fmt.Errorf("%q is not a valid Polarity", name)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Polarity) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Polarity) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParsePolarity(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,31 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolarity(t *testing.T) {
+	tests := map[Polarity]struct {
+		value int
+		name  string
+	}{
+		PolarityUnknown:  {value: -2, name: "unknown"},
+		PolarityNegative: {value: -1, name: "negative"},
+		PolarityNeutral:  {value: 0, name: "neutral"},
+		PolarityPositive: {value: 1, name: "positive"},
+	}
+
+	for x, tc := range tests {
+		assert.Equal(t, tc.value, int(x))
+		assert.Equal(t, tc.name, x.String())
+
+		parsed, err := ParsePolarity(tc.name)
+		require.NoError(t, err)
+		assert.Equal(t, x, parsed)
+	}
+
+	assert.Equal(t, "Polarity(-3)", Polarity(-3).String())
+}
@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --nocase --prefixedstrings
+
+package example
+
+// ENUM(queued, in_flight, done)
+type JobPhase int
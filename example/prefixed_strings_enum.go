@@ -0,0 +1,78 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// JobPhaseQueued is a JobPhase of type Queued.
+	JobPhaseQueued JobPhase = iota
+	// JobPhaseInFlight is a JobPhase of type In_flight.
+	JobPhaseInFlight
+	// JobPhaseDone is a JobPhase of type Done.
+	JobPhaseDone
+)
+
+const _JobPhaseName = "JobPhaseQueuedJobPhaseInFlightJobPhaseDone"
+
+var _JobPhaseMap = map[JobPhase]string{
+	JobPhaseQueued:   _JobPhaseName[0:14],
+	JobPhaseInFlight: _JobPhaseName[14:30],
+	JobPhaseDone:     _JobPhaseName[30:42],
+}
+
+// String implements the Stringer interface.
+func (x JobPhase) String() string {
+	if str, ok := _JobPhaseMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("JobPhase(%d)", x)
+}
+
+var _JobPhaseValue = map[string]JobPhase{
+	_JobPhaseName[0:14]:                   JobPhaseQueued,
+	strings.ToLower(_JobPhaseName[0:14]):  JobPhaseQueued,
+	_JobPhaseName[14:30]:                  JobPhaseInFlight,
+	strings.ToLower(_JobPhaseName[14:30]): JobPhaseInFlight,
+	_JobPhaseName[30:42]:                  JobPhaseDone,
+	strings.ToLower(_JobPhaseName[30:42]): JobPhaseDone,
+}
+
+// ParseJobPhase attempts to convert a string to a JobPhase.
+func ParseJobPhase(name string) (JobPhase, error) {
+	if x, ok := _JobPhaseValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _JobPhaseValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return JobPhase(0), fmt.Errorf("%q is not a valid JobPhase", name)
+}
+
+// MarshalText implements the text marshaller method.
+func (x JobPhase) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *JobPhase) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseJobPhase(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
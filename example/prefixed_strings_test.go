@@ -0,0 +1,42 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobPhasePrefixedStrings(t *testing.T) {
+	tests := map[string]JobPhase{
+		"JobPhaseQueued":   JobPhaseQueued,
+		"JobPhaseInFlight": JobPhaseInFlight,
+		"JobPhaseDone":     JobPhaseDone,
+	}
+
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, name, value.String())
+
+			parsed, err := ParseJobPhase(name)
+			require.NoError(t, err)
+			assert.Equal(t, value, parsed)
+
+			raw, err := json.Marshal(value)
+			require.NoError(t, err)
+			assert.Equal(t, `"`+name+`"`, string(raw))
+
+			var unmarshalled JobPhase
+			require.NoError(t, json.Unmarshal(raw, &unmarshalled))
+			assert.Equal(t, value, unmarshalled)
+		})
+	}
+
+	parsed, err := ParseJobPhase("jobphaseinflight")
+	require.NoError(t, err)
+	assert.Equal(t, JobPhaseInFlight, parsed)
+
+	_, err = ParseJobPhase("queued")
+	assert.Error(t, err)
+}
@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum -f=$GOFILE --proto
+
+package example
+
+// ProtoFruit stands in for a protoc-gen-go generated enum type.
+type ProtoFruit int32
+
+// Fruit is an enumeration that mirrors the ProtoFruit protobuf enum.
+// PROTO(ProtoFruit)
+// ENUM(apple, banana, cherry)
+type Fruit int32
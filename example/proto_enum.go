@@ -0,0 +1,65 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Fruit is an enumeration that mirrors the ProtoFruit protobuf enum.
+const (
+	// FruitApple is a Fruit of type Apple.
+	FruitApple Fruit = iota
+	// FruitBanana is a Fruit of type Banana.
+	FruitBanana
+	// FruitCherry is a Fruit of type Cherry.
+	FruitCherry
+)
+
+const _FruitName = "applebananacherry"
+
+var _FruitMap = map[Fruit]string{
+	FruitApple:  _FruitName[0:5],
+	FruitBanana: _FruitName[5:11],
+	FruitCherry: _FruitName[11:17],
+}
+
+// String implements the Stringer interface.
+func (x Fruit) String() string {
+	if str, ok := _FruitMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Fruit(%d)", x)
+}
+
+var _FruitValue = map[string]Fruit{
+	_FruitName[0:5]:   FruitApple,
+	_FruitName[5:11]:  FruitBanana,
+	_FruitName[11:17]: FruitCherry,
+}
+
+// ParseFruit attempts to convert a string to a Fruit.
+func ParseFruit(name string) (Fruit, error) {
+	if x, ok := _FruitValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Fruit(0), fmt.Errorf("%q is not a valid Fruit", name)
+}
+
+// ToProto converts the Fruit to its protobuf counterpart, ProtoFruit.
+func (x Fruit) ToProto() ProtoFruit {
+	return ProtoFruit(x)
+}
+
+// FruitFromProto converts a ProtoFruit to its Fruit counterpart.
+func FruitFromProto(x ProtoFruit) Fruit {
+	return Fruit(x)
+}
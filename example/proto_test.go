@@ -0,0 +1,14 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFruitProto(t *testing.T) {
+	for _, x := range []Fruit{FruitApple, FruitBanana, FruitCherry} {
+		assert.Equal(t, ProtoFruit(x), x.ToProto())
+		assert.Equal(t, x, FruitFromProto(x.ToProto()))
+	}
+}
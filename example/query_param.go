@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --queryparam --marshal --forcelower
+
+package example
+
+// ENUM(Newest, Oldest, Most Popular)
+type SortOrder int
@@ -0,0 +1,47 @@
+package example
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortOrderQueryParam(t *testing.T) {
+	for _, x := range []SortOrder{SortOrderNewest, SortOrderOldest, SortOrderMostPopular} {
+		b, err := json.Marshal(x)
+		require.NoError(t, err)
+		var str string
+		require.NoError(t, json.Unmarshal(b, &str))
+		assert.Equal(t, str, x.QueryParam())
+
+		parsed, err := ParseSortOrderQueryParam(x.QueryParam())
+		require.NoError(t, err)
+		assert.Equal(t, x, parsed)
+	}
+
+	assert.Equal(t, "most popular", SortOrderMostPopular.QueryParam())
+}
+
+func TestSortOrderQueryParamRoundTrip(t *testing.T) {
+	v := url.Values{}
+	v.Set("sort", SortOrderMostPopular.QueryParam())
+	assert.Equal(t, "sort=most+popular", v.Encode())
+
+	// Decoded query values are already unescaped.
+	decoded, err := url.ParseQuery(v.Encode())
+	require.NoError(t, err)
+	x, err := ParseSortOrderQueryParam(decoded.Get("sort"))
+	require.NoError(t, err)
+	assert.Equal(t, SortOrderMostPopular, x)
+
+	// Raw values that are still escaped are tolerated too.
+	x, err = ParseSortOrderQueryParam("most%20popular")
+	require.NoError(t, err)
+	assert.Equal(t, SortOrderMostPopular, x)
+
+	_, err = ParseSortOrderQueryParam("least%20popular")
+	assert.EqualError(t, err, "\"least popular\" is not a valid SortOrder")
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --rawparse --nocase
+
+package example
+
+// TokenKind is an enumeration of keywords produced by a tokenizer.
+// ENUM(select, from, where, order, limit)
+type TokenKind int
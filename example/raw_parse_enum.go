@@ -0,0 +1,84 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TokenKind is an enumeration of keywords produced by a tokenizer.
+const (
+	// TokenKindSelect is a TokenKind of type Select.
+	TokenKindSelect TokenKind = iota
+	// TokenKindFrom is a TokenKind of type From.
+	TokenKindFrom
+	// TokenKindWhere is a TokenKind of type Where.
+	TokenKindWhere
+	// TokenKindOrder is a TokenKind of type Order.
+	TokenKindOrder
+	// TokenKindLimit is a TokenKind of type Limit.
+	TokenKindLimit
+)
+
+const _TokenKindName = "selectfromwhereorderlimit"
+
+var _TokenKindMap = map[TokenKind]string{
+	TokenKindSelect: _TokenKindName[0:6],
+	TokenKindFrom:   _TokenKindName[6:10],
+	TokenKindWhere:  _TokenKindName[10:15],
+	TokenKindOrder:  _TokenKindName[15:20],
+	TokenKindLimit:  _TokenKindName[20:25],
+}
+
+// String implements the Stringer interface.
+func (x TokenKind) String() string {
+	if str, ok := _TokenKindMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("TokenKind(%d)", x)
+}
+
+var _TokenKindValue = map[string]TokenKind{
+	_TokenKindName[0:6]:                    TokenKindSelect,
+	strings.ToLower(_TokenKindName[0:6]):   TokenKindSelect,
+	_TokenKindName[6:10]:                   TokenKindFrom,
+	strings.ToLower(_TokenKindName[6:10]):  TokenKindFrom,
+	_TokenKindName[10:15]:                  TokenKindWhere,
+	strings.ToLower(_TokenKindName[10:15]): TokenKindWhere,
+	_TokenKindName[15:20]:                  TokenKindOrder,
+	strings.ToLower(_TokenKindName[15:20]): TokenKindOrder,
+	_TokenKindName[20:25]:                  TokenKindLimit,
+	strings.ToLower(_TokenKindName[20:25]): TokenKindLimit,
+}
+
+// ParseTokenKind attempts to convert a string to a TokenKind.
+func ParseTokenKind(name string) (TokenKind, error) {
+	if x, ok := _TokenKindValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _TokenKindValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return TokenKind(0), fmt.Errorf("%q is not a valid TokenKind", name)
+}
+
+// ParseTokenKindToken converts an already tokenized string to a TokenKind, exactly like ParseTokenKind.
+func ParseTokenKindToken(tok string) (TokenKind, error) {
+	return ParseTokenKind(tok)
+}
+
+// ParseTokenKindRaw looks the token up as is, without any normalization, and reports whether it is a valid TokenKind.
+func ParseTokenKindRaw(tok string) (TokenKind, bool) {
+	x, ok := _TokenKindValue[tok]
+	return x, ok
+}
@@ -0,0 +1,40 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenKindRawParse(t *testing.T) {
+	x, err := ParseTokenKindToken("WHERE")
+	require.NoError(t, err)
+	assert.Equal(t, TokenKindWhere, x)
+
+	x, ok := ParseTokenKindRaw("where")
+	assert.True(t, ok)
+	assert.Equal(t, TokenKindWhere, x)
+
+	// No normalization is done, so only exact names are found.
+	_, ok = ParseTokenKindRaw("WHERE")
+	assert.False(t, ok)
+	_, ok = ParseTokenKindRaw(" where")
+	assert.False(t, ok)
+}
+
+var tokenKindTokens = []string{"SELECT", "from", "Where", "order", "LIMIT", "group"}
+
+func BenchmarkTokenKindParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseTokenKindToken(tokenKindTokens[i%len(tokenKindTokens)])
+	}
+}
+
+func BenchmarkTokenKindRawParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseTokenKindRaw(tokenKindTokens[i%len(tokenKindTokens)])
+	}
+}
@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --seq
+
+package example
+
+// ENUM(spring, summer, _, autumn, winter)
+type Season int
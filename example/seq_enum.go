@@ -0,0 +1,90 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"iter"
+)
+
+const (
+	// SeasonSpring is a Season of type Spring.
+	SeasonSpring Season = iota
+	// SeasonSummer is a Season of type Summer.
+	SeasonSummer
+	// Skipped value.
+	_
+	// SeasonAutumn is a Season of type Autumn.
+	SeasonAutumn
+	// SeasonWinter is a Season of type Winter.
+	SeasonWinter
+)
+
+const _SeasonName = "springsummerautumnwinter"
+
+var _SeasonMap = map[Season]string{
+	SeasonSpring: _SeasonName[0:6],
+	SeasonSummer: _SeasonName[6:12],
+	SeasonAutumn: _SeasonName[12:18],
+	SeasonWinter: _SeasonName[18:24],
+}
+
+// String implements the Stringer interface.
+func (x Season) String() string {
+	if str, ok := _SeasonMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Season(%d)", x)
+}
+
+var _SeasonValue = map[string]Season{
+	_SeasonName[0:6]:   SeasonSpring,
+	_SeasonName[6:12]:  SeasonSummer,
+	_SeasonName[12:18]: SeasonAutumn,
+	_SeasonName[18:24]: SeasonWinter,
+}
+
+// ParseSeason attempts to convert a string to a Season.
+func ParseSeason(name string) (Season, error) {
+	if x, ok := _SeasonValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Season(0), fmt.Errorf("%q is not a valid Season", name)
+}
+
+var _SeasonSeqValues = []Season{
+	SeasonSpring,
+	SeasonSummer,
+	SeasonAutumn,
+	SeasonWinter,
+}
+
+// SeasonSeq returns an iterator over the values of Season, in declaration order.
+func SeasonSeq() iter.Seq[Season] {
+	return func(yield func(Season) bool) {
+		for _, x := range _SeasonSeqValues {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// SeasonSeq2 returns an iterator over the declaration order index and value of each Season.
+func SeasonSeq2() iter.Seq2[int, Season] {
+	return func(yield func(int, Season) bool) {
+		for i, x := range _SeasonSeqValues {
+			if !yield(i, x) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+// +build go1.23
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeasonSeq(t *testing.T) {
+	var seasons []Season
+	for x := range SeasonSeq() {
+		seasons = append(seasons, x)
+	}
+	assert.Equal(t, []Season{SeasonSpring, SeasonSummer, SeasonAutumn, SeasonWinter}, seasons)
+
+	indexes := map[int]Season{}
+	for i, x := range SeasonSeq2() {
+		indexes[i] = x
+		if x == SeasonAutumn {
+			break
+		}
+	}
+	assert.Equal(t, map[int]Season{0: SeasonSpring, 1: SeasonSummer, 2: SeasonAutumn}, indexes)
+}
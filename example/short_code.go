@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --shortcode
+
+package example
+
+// Region is a hosting region, shared in short links.
+// ENUM(us_east, us_west, eu_central, _, ap_south, ap_north, sa_east)
+type Region int
@@ -0,0 +1,30 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionShortCode(t *testing.T) {
+	golden := map[Region]string{
+		RegionUsEast:    "AA",
+		RegionUsWest:    "AQ",
+		RegionEuCentral: "Ag",
+		RegionApSouth:   "Aw",
+		RegionApNorth:   "BA",
+		RegionSaEast:    "BQ",
+	}
+	for x, code := range golden {
+		assert.Equal(t, code, x.ShortCode(), "%s", x)
+
+		decoded, err := RegionFromShortCode(code)
+		require.NoError(t, err)
+		assert.Equal(t, x, decoded)
+	}
+
+	assert.Equal(t, "", Region(3).ShortCode(), "skipped values have no code")
+	_, err := RegionFromShortCode("Bg")
+	assert.EqualError(t, err, `"Bg" is not a valid Region short code`)
+}
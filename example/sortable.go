@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --sortable
+
+package example
+
+// ENUM(low=10, critical=40, medium=20, high=30)
+type Severity int
@@ -0,0 +1,65 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// SeverityLow is a Severity of type Low.
+	SeverityLow Severity = iota + 10
+	// SeverityCritical is a Severity of type Critical.
+	SeverityCritical Severity = iota + 39
+	// SeverityMedium is a Severity of type Medium.
+	SeverityMedium Severity = iota + 18
+	// SeverityHigh is a Severity of type High.
+	SeverityHigh Severity = iota + 27
+)
+
+const _SeverityName = "lowcriticalmediumhigh"
+
+var _SeverityMap = map[Severity]string{
+	SeverityLow:      _SeverityName[0:3],
+	SeverityCritical: _SeverityName[3:11],
+	SeverityMedium:   _SeverityName[11:17],
+	SeverityHigh:     _SeverityName[17:21],
+}
+
+// String implements the Stringer interface.
+func (x Severity) String() string {
+	if str, ok := _SeverityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Severity(%d)", x)
+}
+
+var _SeverityValue = map[string]Severity{
+	_SeverityName[0:3]:   SeverityLow,
+	_SeverityName[3:11]:  SeverityCritical,
+	_SeverityName[11:17]: SeverityMedium,
+	_SeverityName[17:21]: SeverityHigh,
+}
+
+// ParseSeverity attempts to convert a string to a Severity.
+func ParseSeverity(name string) (Severity, error) {
+	if x, ok := _SeverityValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Severity(0), fmt.Errorf("%q is not a valid Severity", name)
+}
+
+// SeveritySlice attaches the methods of sort.Interface to []Severity, sorting in increasing value order.
+type SeveritySlice []Severity
+
+func (x SeveritySlice) Len() int           { return len(x) }
+func (x SeveritySlice) Less(i, j int) bool { return x[i] < x[j] }
+func (x SeveritySlice) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
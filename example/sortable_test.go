@@ -0,0 +1,21 @@
+package example
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeveritySlice(t *testing.T) {
+	xs := []Severity{SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical, SeverityLow}
+	r := rand.New(rand.NewSource(1))
+	r.Shuffle(len(xs), func(i, j int) { xs[i], xs[j] = xs[j], xs[i] })
+
+	sort.Sort(SeveritySlice(xs))
+	assert.Equal(t, []Severity{SeverityLow, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}, xs)
+
+	sort.Sort(sort.Reverse(SeveritySlice(xs)))
+	assert.Equal(t, []Severity{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityLow}, xs)
+}
@@ -0,0 +1,15 @@
+//go:generate ../bin/go-enum -f=$GOFILE --sortedparse --nocase
+
+package example
+
+// Coin is an enumeration of coins that is parsed without a lookup map.
+/*
+ENUM(
+	penny,
+	nickel,
+	dime,
+	quarter,
+	dollar,
+)
+*/
+type Coin int
@@ -0,0 +1,81 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Coin is an enumeration of coins that is parsed without a lookup map.
+const (
+	// CoinPenny is a Coin of type Penny.
+	CoinPenny Coin = iota
+	// CoinNickel is a Coin of type Nickel.
+	CoinNickel
+	// CoinDime is a Coin of type Dime.
+	CoinDime
+	// CoinQuarter is a Coin of type Quarter.
+	CoinQuarter
+	// CoinDollar is a Coin of type Dollar.
+	CoinDollar
+)
+
+const _CoinName = "pennynickeldimequarterdollar"
+
+var _CoinMap = map[Coin]string{
+	CoinPenny:   _CoinName[0:5],
+	CoinNickel:  _CoinName[5:11],
+	CoinDime:    _CoinName[11:15],
+	CoinQuarter: _CoinName[15:22],
+	CoinDollar:  _CoinName[22:28],
+}
+
+// String implements the Stringer interface.
+func (x Coin) String() string {
+	if str, ok := _CoinMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Coin(%d)", x)
+}
+
+var _CoinSorted = [...]struct {
+	name  string
+	value Coin
+}{
+	{"dime", CoinDime},
+	{"dollar", CoinDollar},
+	{"nickel", CoinNickel},
+	{"penny", CoinPenny},
+	{"quarter", CoinQuarter},
+}
+
+// _CoinLookup binary searches the sorted names for an exact match.
+func _CoinLookup(name string) (Coin, bool) {
+	i := sort.Search(len(_CoinSorted), func(i int) bool { return _CoinSorted[i].name >= name })
+	if i < len(_CoinSorted) && _CoinSorted[i].name == name {
+		return _CoinSorted[i].value, true
+	}
+	return Coin(0), false
+}
+
+// ParseCoin attempts to convert a string to a Coin.
+func ParseCoin(name string) (Coin, error) {
+	if x, ok := _CoinLookup(name); ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _CoinLookup(strings.ToLower(name)); ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Coin(0), fmt.Errorf("%q is not a valid Coin", name)
+}
@@ -0,0 +1,47 @@
+package example
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoinSortedParse(t *testing.T) {
+	for _, x := range []Coin{CoinPenny, CoinNickel, CoinDime, CoinQuarter, CoinDollar} {
+		parsed, err := ParseCoin(x.String())
+		require.NoError(t, err)
+		assert.Equal(t, x, parsed)
+	}
+
+	parsed, err := ParseCoin("QUARTER")
+	require.NoError(t, err)
+	assert.Equal(t, CoinQuarter, parsed)
+
+	for _, name := range []string{"", "a", "cent", "dimes", "zloty"} {
+		_, err = ParseCoin(name)
+		assert.EqualError(t, err, strconv.Quote(name)+" is not a valid Coin")
+	}
+}
+
+var coinParseNames = []string{"penny", "nickel", "dime", "quarter", "dollar", "euro"}
+
+func BenchmarkCoinSortedParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = _CoinLookup(coinParseNames[i%len(coinParseNames)])
+	}
+}
+
+func BenchmarkCoinMapParse(b *testing.B) {
+	m := map[string]Coin{}
+	for _, x := range _CoinSorted {
+		m[x.name] = x.value
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = m[coinParseNames[i%len(coinParseNames)]]
+	}
+}
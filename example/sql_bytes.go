@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --sql --nocase
+
+package example
+
+// CharColumn is stored as a CHAR column, which the MySQL driver returns as []uint8.
+// ENUM(Open, Closed)
+type CharColumn int
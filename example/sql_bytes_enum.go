@@ -0,0 +1,142 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CharColumn is stored as a CHAR column, which the MySQL driver returns as []uint8.
+const (
+	// CharColumnOpen is a CharColumn of type Open.
+	CharColumnOpen CharColumn = iota
+	// CharColumnClosed is a CharColumn of type Closed.
+	CharColumnClosed
+)
+
+const _CharColumnName = "OpenClosed"
+
+var _CharColumnMap = map[CharColumn]string{
+	CharColumnOpen:   _CharColumnName[0:4],
+	CharColumnClosed: _CharColumnName[4:10],
+}
+
+// String implements the Stringer interface.
+func (x CharColumn) String() string {
+	if str, ok := _CharColumnMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("CharColumn(%d)", x)
+}
+
+var _CharColumnValue = map[string]CharColumn{
+	_CharColumnName[0:4]:                   CharColumnOpen,
+	strings.ToLower(_CharColumnName[0:4]):  CharColumnOpen,
+	_CharColumnName[4:10]:                  CharColumnClosed,
+	strings.ToLower(_CharColumnName[4:10]): CharColumnClosed,
+}
+
+// ParseCharColumn attempts to convert a string to a CharColumn.
+func ParseCharColumn(name string) (CharColumn, error) {
+	if x, ok := _CharColumnValue[name]; ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
+	if x, ok := _CharColumnValue[strings.ToLower(name)]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return CharColumn(0), fmt.Errorf("%q is not a valid CharColumn", name)
+}
+
+var _CharColumnErrNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *CharColumn) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = CharColumn(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = CharColumn(v)
+	case string:
+		*x, err = ParseCharColumn(v)
+	case []byte:
+		*x, err = ParseCharColumn(string(v))
+	case CharColumn:
+		*x = v
+	case int:
+		*x = CharColumn(v)
+	case *CharColumn:
+		if v == nil {
+			return _CharColumnErrNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = CharColumn(v)
+	case uint64:
+		*x = CharColumn(v)
+	case *int:
+		if v == nil {
+			return _CharColumnErrNilPtr
+		}
+		*x = CharColumn(*v)
+	case *int64:
+		if v == nil {
+			return _CharColumnErrNilPtr
+		}
+		*x = CharColumn(*v)
+	case json.Number:
+		var val int64
+		val, err = strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid CharColumn: %w", v, err)
+		}
+		*x = CharColumn(val)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = CharColumn(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return _CharColumnErrNilPtr
+		}
+		*x = CharColumn(*v)
+	case *uint:
+		if v == nil {
+			return _CharColumnErrNilPtr
+		}
+		*x = CharColumn(*v)
+	case *uint64:
+		if v == nil {
+			return _CharColumnErrNilPtr
+		}
+		*x = CharColumn(*v)
+	case *string:
+		if v == nil {
+			return _CharColumnErrNilPtr
+		}
+		*x, err = ParseCharColumn(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x CharColumn) Value() (driver.Value, error) {
+	return x.String(), nil
+}
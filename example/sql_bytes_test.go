@@ -0,0 +1,43 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharColumnScanBytes(t *testing.T) {
+	tests := map[string]struct {
+		input  interface{}
+		output CharColumn
+	}{
+		"[]byte": {
+			input:  []byte("Closed"),
+			output: CharColumnClosed,
+		},
+		"[]uint8 upper case": {
+			input:  []uint8("CLOSED"),
+			output: CharColumnClosed,
+		},
+		"[]byte lower case": {
+			input:  []byte("open"),
+			output: CharColumnOpen,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var x CharColumn
+			require.NoError(t, x.Scan(tc.input))
+			assert.Equal(t, tc.output, x)
+
+			value, err := x.Value()
+			require.NoError(t, err)
+			assert.Equal(t, tc.output.String(), value)
+		})
+	}
+
+	var x CharColumn
+	assert.EqualError(t, x.Scan([]byte("ajar")), "\"ajar\" is not a valid CharColumn")
+}
@@ -0,0 +1,9 @@
+//go:generate ../bin/go-enum -f=$GOFILE --sqlddl --forcelower
+
+package example
+
+// ENUM(Active, _, Suspended, Closed)
+type AccountStatus int
+
+// ENUM(o'clock, noon)
+type TimeOfDay int
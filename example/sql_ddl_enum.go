@@ -0,0 +1,119 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// AccountStatusActive is a AccountStatus of type Active.
+	AccountStatusActive AccountStatus = iota
+	// Skipped value.
+	_
+	// AccountStatusSuspended is a AccountStatus of type Suspended.
+	AccountStatusSuspended
+	// AccountStatusClosed is a AccountStatus of type Closed.
+	AccountStatusClosed
+)
+
+const _AccountStatusName = "activesuspendedclosed"
+
+var _AccountStatusMap = map[AccountStatus]string{
+	AccountStatusActive:    _AccountStatusName[0:6],
+	AccountStatusSuspended: _AccountStatusName[6:15],
+	AccountStatusClosed:    _AccountStatusName[15:21],
+}
+
+// String implements the Stringer interface.
+func (x AccountStatus) String() string {
+	if str, ok := _AccountStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("AccountStatus(%d)", x)
+}
+
+var _AccountStatusValue = map[string]AccountStatus{
+	_AccountStatusName[0:6]:   AccountStatusActive,
+	_AccountStatusName[6:15]:  AccountStatusSuspended,
+	_AccountStatusName[15:21]: AccountStatusClosed,
+}
+
+// ParseAccountStatus attempts to convert a string to a AccountStatus.
+func ParseAccountStatus(name string) (AccountStatus, error) {
+	if x, ok := _AccountStatusValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return AccountStatus(0), fmt.Errorf("%q is not a valid AccountStatus", name)
+}
+
+const _AccountStatusSQLValues = "'active','suspended','closed'"
+
+// AccountStatusSQLCheck returns a CHECK constraint limiting the column to the AccountStatus values.
+func AccountStatusSQLCheck(column string) string {
+	return "CHECK (" + column + " IN (" + _AccountStatusSQLValues + "))"
+}
+
+// AccountStatusPostgresEnumDDL returns a CREATE TYPE statement for a postgres enum type holding the AccountStatus values.
+func AccountStatusPostgresEnumDDL(typeName string) string {
+	return "CREATE TYPE " + typeName + " AS ENUM (" + _AccountStatusSQLValues + ")"
+}
+
+const (
+	// TimeOfDayOClock is a TimeOfDay of type O'Clock.
+	TimeOfDayOClock TimeOfDay = iota
+	// TimeOfDayNoon is a TimeOfDay of type Noon.
+	TimeOfDayNoon
+)
+
+const _TimeOfDayName = "o'clocknoon"
+
+var _TimeOfDayMap = map[TimeOfDay]string{
+	TimeOfDayOClock: _TimeOfDayName[0:7],
+	TimeOfDayNoon:   _TimeOfDayName[7:11],
+}
+
+// String implements the Stringer interface.
+func (x TimeOfDay) String() string {
+	if str, ok := _TimeOfDayMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("TimeOfDay(%d)", x)
+}
+
+var _TimeOfDayValue = map[string]TimeOfDay{
+	_TimeOfDayName[0:7]:  TimeOfDayOClock,
+	_TimeOfDayName[7:11]: TimeOfDayNoon,
+}
+
+// ParseTimeOfDay attempts to convert a string to a TimeOfDay.
+func ParseTimeOfDay(name string) (TimeOfDay, error) {
+	if x, ok := _TimeOfDayValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return TimeOfDay(0), fmt.Errorf("%q is not a valid TimeOfDay", name)
+}
+
+const _TimeOfDaySQLValues = "'o''clock','noon'"
+
+// TimeOfDaySQLCheck returns a CHECK constraint limiting the column to the TimeOfDay values.
+func TimeOfDaySQLCheck(column string) string {
+	return "CHECK (" + column + " IN (" + _TimeOfDaySQLValues + "))"
+}
+
+// TimeOfDayPostgresEnumDDL returns a CREATE TYPE statement for a postgres enum type holding the TimeOfDay values.
+func TimeOfDayPostgresEnumDDL(typeName string) string {
+	return "CREATE TYPE " + typeName + " AS ENUM (" + _TimeOfDaySQLValues + ")"
+}
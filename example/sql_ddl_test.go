@@ -0,0 +1,17 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountStatusSQLDDL(t *testing.T) {
+	assert.Equal(t, "CHECK (status IN ('active','suspended','closed'))", AccountStatusSQLCheck("status"))
+	assert.Equal(t, "CREATE TYPE account_status AS ENUM ('active','suspended','closed')", AccountStatusPostgresEnumDDL("account_status"))
+}
+
+func TestTimeOfDaySQLDDLQuoting(t *testing.T) {
+	assert.Equal(t, "CHECK (at IN ('o''clock','noon'))", TimeOfDaySQLCheck("at"))
+	assert.Equal(t, "o'clock", TimeOfDayOClock.String())
+}
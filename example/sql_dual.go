@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --sqldual=string
+
+package example
+
+// Carrier is stored as its name in the shipments table and as its value in the legacy invoices table.
+// ENUM(dhl=1, ups, fedex, postnl=10)
+type Carrier int
@@ -0,0 +1,121 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// Carrier is stored as its name in the shipments table and as its value in the legacy invoices table.
+const (
+	// CarrierDhl is a Carrier of type Dhl.
+	CarrierDhl Carrier = iota + 1
+	// CarrierUps is a Carrier of type Ups.
+	CarrierUps
+	// CarrierFedex is a Carrier of type Fedex.
+	CarrierFedex
+	// CarrierPostnl is a Carrier of type Postnl.
+	CarrierPostnl Carrier = iota + 7
+)
+
+const _CarrierName = "dhlupsfedexpostnl"
+
+var _CarrierMap = map[Carrier]string{
+	CarrierDhl:    _CarrierName[0:3],
+	CarrierUps:    _CarrierName[3:6],
+	CarrierFedex:  _CarrierName[6:11],
+	CarrierPostnl: _CarrierName[11:17],
+}
+
+// String implements the Stringer interface.
+func (x Carrier) String() string {
+	if str, ok := _CarrierMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Carrier(%d)", x)
+}
+
+var _CarrierValue = map[string]Carrier{
+	_CarrierName[0:3]:   CarrierDhl,
+	_CarrierName[3:6]:   CarrierUps,
+	_CarrierName[6:11]:  CarrierFedex,
+	_CarrierName[11:17]: CarrierPostnl,
+}
+
+// ParseCarrier attempts to convert a string to a Carrier.
+func ParseCarrier(name string) (Carrier, error) {
+	if x, ok := _CarrierValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Carrier(0), fmt.Errorf("%q is not a valid Carrier", name)
+}
+
+var _CarrierErrNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+// Strings are parsed as the name of the Carrier, integers are taken as its value, and both must be defined.
+func (x *Carrier) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Carrier(0)
+		return
+	}
+
+	var val int64
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseCarrier(v)
+		return
+	case []byte:
+		*x, err = ParseCarrier(string(v))
+		return
+	case *string:
+		if v == nil {
+			return _CarrierErrNilPtr
+		}
+		*x, err = ParseCarrier(*v)
+		return
+	case Carrier:
+		val = int64(v)
+	case *Carrier:
+		if v == nil {
+			return _CarrierErrNilPtr
+		}
+		val = int64(*v)
+	case int64:
+		val = v
+	case int:
+		val = int64(v)
+	case int32:
+		val = int64(v)
+	case uint64:
+		val = int64(v)
+	case *int64:
+		if v == nil {
+			return _CarrierErrNilPtr
+		}
+		val = *v
+	default:
+		return fmt.Errorf("cannot scan %T into Carrier", value)
+	}
+
+	if _, ok := _CarrierMap[Carrier(val)]; !ok || int64(Carrier(val)) != val {
+		return fmt.Errorf("%d is not a valid Carrier", val)
+	}
+	*x = Carrier(val)
+	return
+}
+
+// Value implements the driver Valuer interface, writing the Carrier as its name.
+func (x Carrier) Value() (driver.Value, error) {
+	return x.String(), nil
+}
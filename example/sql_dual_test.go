@@ -0,0 +1,50 @@
+package example
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCarrierScan(t *testing.T) {
+	str := "fedex"
+	num := int64(10)
+	tests := map[string]struct {
+		value    interface{}
+		expected Carrier
+	}{
+		"string":     {value: "ups", expected: CarrierUps},
+		"bytes":      {value: []byte("dhl"), expected: CarrierDhl},
+		"string ptr": {value: &str, expected: CarrierFedex},
+		"int64":      {value: int64(2), expected: CarrierUps},
+		"int":        {value: 10, expected: CarrierPostnl},
+		"int64 ptr":  {value: &num, expected: CarrierPostnl},
+		"carrier":    {value: CarrierFedex, expected: CarrierFedex},
+		"nil":        {value: nil, expected: Carrier(0)},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var x Carrier
+			require.NoError(t, x.Scan(tc.value))
+			assert.Equal(t, tc.expected, x)
+		})
+	}
+}
+
+func TestCarrierScanInvalid(t *testing.T) {
+	var x Carrier
+	assert.EqualError(t, x.Scan(int64(4)), "4 is not a valid Carrier")
+	assert.EqualError(t, x.Scan("royalmail"), "\"royalmail\" is not a valid Carrier")
+	assert.EqualError(t, x.Scan("2"), "\"2\" is not a valid Carrier", "strings are names, not values")
+	assert.EqualError(t, x.Scan(2.0), "cannot scan float64 into Carrier")
+	assert.EqualError(t, x.Scan((*int64)(nil)), "value pointer is nil")
+}
+
+func TestCarrierValue(t *testing.T) {
+	val, err := CarrierPostnl.Value()
+	require.NoError(t, err)
+	assert.Equal(t, driver.Value("postnl"), val)
+}
@@ -7,10 +7,12 @@
 package example
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 )
 
@@ -54,7 +56,11 @@ func ParseProjectStatus(name string) (ProjectStatus, error) {
 	if x, ok := _ProjectStatusValue[name]; ok {
 		return x, nil
 	}
-	return ProjectStatus(0), fmt.Errorf("%s is not a valid ProjectStatus", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ProjectStatus(0), fmt.Errorf("%q is not a valid ProjectStatus", name)
 }
 
 func (x ProjectStatus) Ptr() *ProjectStatus {
@@ -130,6 +136,13 @@ func (x *ProjectStatus) Scan(value interface{}) (err error) {
 			return _ProjectStatusErrNilPtr
 		}
 		*x = ProjectStatus(*v)
+	case json.Number:
+		var val int64
+		val, err = strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid ProjectStatus: %w", v, err)
+		}
+		*x = ProjectStatus(val)
 	case float64: // json marshals everything as a float64 if it's a number
 		*x = ProjectStatus(v)
 	case *float64: // json marshals everything as a float64 if it's a number
@@ -214,10 +227,16 @@ func (n NullProjectStatus) MarshalJSON() ([]byte, error) {
 func (n *NullProjectStatus) UnmarshalJSON(b []byte) error {
 	n.Set = true
 	var x interface{}
-	err := json.Unmarshal(b, &x)
+	// Decode numbers as json.Number so that large values keep their exact digits.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err := dec.Decode(&x)
 	if err != nil {
 		return err
 	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("invalid JSON for NullProjectStatus, unexpected data after the value")
+	}
 	err = n.Scan(x)
 	return err
 }
@@ -252,10 +271,16 @@ func (n NullProjectStatusStr) MarshalJSON() ([]byte, error) {
 func (n *NullProjectStatusStr) UnmarshalJSON(b []byte) error {
 	n.Set = true
 	var x interface{}
-	err := json.Unmarshal(b, &x)
+	// Decode numbers as json.Number so that large values keep their exact digits.
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	err := dec.Decode(&x)
 	if err != nil {
 		return err
 	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("invalid JSON for NullProjectStatusStr, unexpected data after the value")
+	}
 	err = n.Scan(x)
 	return err
 }
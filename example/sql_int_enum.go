@@ -8,6 +8,7 @@ package example
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -57,7 +58,11 @@ func ParseImageType(name string) (ImageType, error) {
 	if x, ok := _ImageTypeValue[name]; ok {
 		return x, nil
 	}
-	return ImageType(0), fmt.Errorf("%s is not a valid ImageType", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ImageType(0), fmt.Errorf("%q is not a valid ImageType", name)
 }
 
 var _ImageTypeErrNilPtr = errors.New("value pointer is nil") // one per type for package clashes
@@ -113,6 +118,13 @@ func (x *ImageType) Scan(value interface{}) (err error) {
 			return _ImageTypeErrNilPtr
 		}
 		*x = ImageType(*v)
+	case json.Number:
+		var val int64
+		val, err = strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid ImageType: %w", v, err)
+		}
+		*x = ImageType(val)
 	case float64: // json marshals everything as a float64 if it's a number
 		*x = ImageType(v)
 	case *float64: // json marshals everything as a float64 if it's a number
@@ -8,8 +8,10 @@ package example
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 )
 
 const (
@@ -52,7 +54,11 @@ func ParseJobState(name string) (JobState, error) {
 	if x, ok := _JobStateValue[name]; ok {
 		return x, nil
 	}
-	return JobState(0), fmt.Errorf("%s is not a valid JobState", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return JobState(0), fmt.Errorf("%q is not a valid JobState", name)
 }
 
 var _JobStateErrNilPtr = errors.New("value pointer is nil") // one per type for package clashes
@@ -96,6 +102,13 @@ func (x *JobState) Scan(value interface{}) (err error) {
 			return _JobStateErrNilPtr
 		}
 		*x = JobState(*v)
+	case json.Number:
+		var val int64
+		val, err = strconv.ParseInt(v.String(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid JobState: %w", v, err)
+		}
+		*x = JobState(val)
 	case float64: // json marshals everything as a float64 if it's a number
 		*x = JobState(v)
 	case *float64: // json marshals everything as a float64 if it's a number
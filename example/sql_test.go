@@ -198,4 +198,8 @@ func TestSQLMarshal(t *testing.T) {
 
 	require.Error(t, json.Unmarshal([]byte(`{"status2":"xyz"}`), &val2))
 
+	var status NullProjectStatus
+	assert.EqualError(t, status.UnmarshalJSON([]byte(`1 2`)), "invalid JSON for NullProjectStatus, unexpected data after the value")
+	var statusStr NullProjectStatusStr
+	assert.EqualError(t, statusStr.UnmarshalJSON([]byte(`"pending" "done"`)), "invalid JSON for NullProjectStatusStr, unexpected data after the value")
 }
@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum -f=$GOFILE
+
+package example
+
+// HTTPClass is an enumeration of response classes spaced like their status codes.
+// ENUM(informational=100, success, redirection, clientError, serverError) step=100
+type HTTPClass int
+
+// Tier is an enumeration of tiers spaced by 10, with an explicit value in the middle.
+// ENUM(bronze, silver, gold=25, platinum, diamond) step=10
+type Tier uint
@@ -0,0 +1,115 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// HTTPClass is an enumeration of response classes spaced like their status codes.
+const (
+	// HTTPClassInformational is a HTTPClass of type Informational.
+	HTTPClassInformational HTTPClass = iota + 100
+	// HTTPClassSuccess is a HTTPClass of type Success.
+	HTTPClassSuccess HTTPClass = iota + 199
+	// HTTPClassRedirection is a HTTPClass of type Redirection.
+	HTTPClassRedirection HTTPClass = iota + 298
+	// HTTPClassClientError is a HTTPClass of type ClientError.
+	HTTPClassClientError HTTPClass = iota + 397
+	// HTTPClassServerError is a HTTPClass of type ServerError.
+	HTTPClassServerError HTTPClass = iota + 496
+)
+
+const _HTTPClassName = "informationalsuccessredirectionclientErrorserverError"
+
+var _HTTPClassMap = map[HTTPClass]string{
+	HTTPClassInformational: _HTTPClassName[0:13],
+	HTTPClassSuccess:       _HTTPClassName[13:20],
+	HTTPClassRedirection:   _HTTPClassName[20:31],
+	HTTPClassClientError:   _HTTPClassName[31:42],
+	HTTPClassServerError:   _HTTPClassName[42:53],
+}
+
+// String implements the Stringer interface.
+func (x HTTPClass) String() string {
+	if str, ok := _HTTPClassMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("HTTPClass(%d)", x)
+}
+
+var _HTTPClassValue = map[string]HTTPClass{
+	_HTTPClassName[0:13]:  HTTPClassInformational,
+	_HTTPClassName[13:20]: HTTPClassSuccess,
+	_HTTPClassName[20:31]: HTTPClassRedirection,
+	_HTTPClassName[31:42]: HTTPClassClientError,
+	_HTTPClassName[42:53]: HTTPClassServerError,
+}
+
+// ParseHTTPClass attempts to convert a string to a HTTPClass.
+func ParseHTTPClass(name string) (HTTPClass, error) {
+	if x, ok := _HTTPClassValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return HTTPClass(0), fmt.Errorf("%q is not a valid HTTPClass", name)
+}
+
+// Tier is an enumeration of tiers spaced by 10, with an explicit value in the middle.
+const (
+	// TierBronze is a Tier of type Bronze.
+	TierBronze Tier = iota
+	// TierSilver is a Tier of type Silver.
+	TierSilver Tier = iota + 9
+	// TierGold is a Tier of type Gold.
+	TierGold Tier = iota + 23
+	// TierPlatinum is a Tier of type Platinum.
+	TierPlatinum Tier = iota + 32
+	// TierDiamond is a Tier of type Diamond.
+	TierDiamond Tier = iota + 41
+)
+
+const _TierName = "bronzesilvergoldplatinumdiamond"
+
+var _TierMap = map[Tier]string{
+	TierBronze:   _TierName[0:6],
+	TierSilver:   _TierName[6:12],
+	TierGold:     _TierName[12:16],
+	TierPlatinum: _TierName[16:24],
+	TierDiamond:  _TierName[24:31],
+}
+
+// String implements the Stringer interface.
+func (x Tier) String() string {
+	if str, ok := _TierMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Tier(%d)", x)
+}
+
+var _TierValue = map[string]Tier{
+	_TierName[0:6]:   TierBronze,
+	_TierName[6:12]:  TierSilver,
+	_TierName[12:16]: TierGold,
+	_TierName[16:24]: TierPlatinum,
+	_TierName[24:31]: TierDiamond,
+}
+
+// ParseTier attempts to convert a string to a Tier.
+func ParseTier(name string) (Tier, error) {
+	if x, ok := _TierValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Tier(0), fmt.Errorf("%q is not a valid Tier", name)
+}
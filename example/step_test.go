@@ -0,0 +1,25 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPClassStep(t *testing.T) {
+	assert.Equal(t, HTTPClass(100), HTTPClassInformational)
+	assert.Equal(t, HTTPClass(200), HTTPClassSuccess)
+	assert.Equal(t, HTTPClass(300), HTTPClassRedirection)
+	assert.Equal(t, HTTPClass(400), HTTPClassClientError)
+	assert.Equal(t, HTTPClass(500), HTTPClassServerError)
+	assert.Equal(t, "clientError", HTTPClass(400).String())
+}
+
+func TestTierStep(t *testing.T) {
+	assert.Equal(t, Tier(0), TierBronze)
+	assert.Equal(t, Tier(10), TierSilver)
+	// Explicit values still override, and implicit values continue from them.
+	assert.Equal(t, Tier(25), TierGold)
+	assert.Equal(t, Tier(35), TierPlatinum)
+	assert.Equal(t, Tier(45), TierDiamond)
+}
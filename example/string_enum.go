@@ -0,0 +1,15 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --sql --names --nocase --mustparse --validate
+
+package example
+
+// CurrencyCode is an enumeration of ISO 4217 currency codes, stored as their code.
+/*
+ENUM(
+USD
+EUR // Euro
+GBP
+_
+XBT = "bitcoin" // Not an ISO 4217 code
+)
+*/
+type CurrencyCode string
@@ -0,0 +1,57 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyCodeString(t *testing.T) {
+	assert.Equal(t, CurrencyCode("USD"), CurrencyCodeUSD)
+	assert.Equal(t, "EUR", CurrencyCodeEUR.String())
+	assert.Equal(t, "bitcoin", CurrencyCodeXBT.String())
+	assert.Equal(t, []string{"USD", "EUR", "GBP", "bitcoin"}, CurrencyCodeNames())
+
+	assert.True(t, CurrencyCodeGBP.IsValid())
+	assert.False(t, CurrencyCode("JPY").IsValid())
+	assert.False(t, CurrencyCode("usd").IsValid())
+}
+
+func TestCurrencyCodeParse(t *testing.T) {
+	x, err := ParseCurrencyCode("gbp")
+	require.NoError(t, err)
+	assert.Equal(t, CurrencyCodeGBP, x)
+	assert.Equal(t, CurrencyCodeXBT, MustParseCurrencyCode("Bitcoin"))
+
+	_, err = ParseCurrencyCode("JPY")
+	assert.EqualError(t, err, "\"JPY\" is not a valid CurrencyCode, try [USD, EUR, GBP, bitcoin]")
+}
+
+func TestCurrencyCodeMarshal(t *testing.T) {
+	type price struct {
+		Currency CurrencyCode `json:"currency"`
+	}
+	b, err := json.Marshal(price{Currency: CurrencyCodeEUR})
+	require.NoError(t, err)
+	assert.Equal(t, `{"currency":"EUR"}`, string(b))
+
+	var p price
+	require.NoError(t, json.Unmarshal([]byte(`{"currency":"usd"}`), &p))
+	assert.Equal(t, CurrencyCodeUSD, p.Currency)
+	assert.EqualError(t, json.Unmarshal([]byte(`{"currency":"JPY"}`), &p), "\"JPY\" is not a valid CurrencyCode, try [USD, EUR, GBP, bitcoin]")
+}
+
+func TestCurrencyCodeSQL(t *testing.T) {
+	var x CurrencyCode
+	require.NoError(t, x.Scan([]byte("GBP")))
+	assert.Equal(t, CurrencyCodeGBP, x)
+	require.NoError(t, x.Scan(nil))
+	assert.Equal(t, CurrencyCode(""), x)
+	assert.EqualError(t, x.Scan(12), "cannot scan int into CurrencyCode")
+
+	val, err := CurrencyCodeXBT.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "bitcoin", val)
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --values --textappender --xml --yaml --toml
+
+package example
+
+// Topping is a pizza topping, stored as its name in every format.
+// ENUM(cheese, mushroom, pepperoni)
+type Topping string
@@ -0,0 +1,58 @@
+package example
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestToppingValues(t *testing.T) {
+	assert.Equal(t, []Topping{ToppingCheese, ToppingMushroom, ToppingPepperoni}, ToppingValues())
+
+	b, err := ToppingMushroom.AppendText([]byte("topping="))
+	require.NoError(t, err)
+	assert.Equal(t, "topping=mushroom", string(b))
+}
+
+func TestToppingXML(t *testing.T) {
+	type order struct {
+		XMLName xml.Name `xml:"order"`
+		Extra   Topping  `xml:"extra,attr"`
+		Topping Topping  `xml:"topping"`
+	}
+
+	data, err := xml.Marshal(order{Extra: ToppingCheese, Topping: ToppingPepperoni})
+	require.NoError(t, err)
+	assert.Equal(t, `<order extra="cheese"><topping>pepperoni</topping></order>`, string(data))
+
+	var decoded order
+	require.NoError(t, xml.Unmarshal(data, &decoded))
+	assert.Equal(t, ToppingCheese, decoded.Extra)
+	assert.Equal(t, ToppingPepperoni, decoded.Topping)
+
+	assert.EqualError(t, xml.Unmarshal([]byte(`<order><topping>pineapple</topping></order>`), &decoded), "\"pineapple\" is not a valid Topping")
+}
+
+func TestToppingYAML(t *testing.T) {
+	data, err := yaml.Marshal(map[string]Topping{"topping": ToppingMushroom})
+	require.NoError(t, err)
+	assert.Equal(t, "topping: mushroom\n", string(data))
+
+	var decoded map[string]Topping
+	require.NoError(t, yaml.Unmarshal(data, &decoded))
+	assert.Equal(t, ToppingMushroom, decoded["topping"])
+}
+
+func TestToppingTOML(t *testing.T) {
+	data, err := ToppingCheese.MarshalTOML()
+	require.NoError(t, err)
+	assert.Equal(t, `"cheese"`, string(data))
+
+	var x Topping
+	require.NoError(t, x.UnmarshalTOML("pepperoni"))
+	assert.Equal(t, ToppingPepperoni, x)
+	assert.EqualError(t, x.UnmarshalTOML(3), "cannot unmarshal int into Topping, expected a string")
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal "--stringtemplate={{.Name | title}} (#{{.Value}})"
+
+package example
+
+// Swatch is an enumeration of palette swatches, shown with their palette index.
+// ENUM(red, green, blue)
+type Swatch int
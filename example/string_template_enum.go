@@ -0,0 +1,71 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Swatch is an enumeration of palette swatches, shown with their palette index.
+const (
+	// SwatchRed is a Swatch of type Red.
+	SwatchRed Swatch = iota
+	// SwatchGreen is a Swatch of type Green.
+	SwatchGreen
+	// SwatchBlue is a Swatch of type Blue.
+	SwatchBlue
+)
+
+const _SwatchName = "Red (#0)Green (#1)Blue (#2)"
+
+var _SwatchMap = map[Swatch]string{
+	SwatchRed:   _SwatchName[0:8],
+	SwatchGreen: _SwatchName[8:18],
+	SwatchBlue:  _SwatchName[18:27],
+}
+
+// String implements the Stringer interface.
+func (x Swatch) String() string {
+	if str, ok := _SwatchMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Swatch(%d)", x)
+}
+
+var _SwatchValue = map[string]Swatch{
+	_SwatchName[0:8]:   SwatchRed,
+	_SwatchName[8:18]:  SwatchGreen,
+	_SwatchName[18:27]: SwatchBlue,
+}
+
+// ParseSwatch attempts to convert a string to a Swatch.
+func ParseSwatch(name string) (Swatch, error) {
+	if x, ok := _SwatchValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Swatch(0), fmt.Errorf("%q is not a valid Swatch", name)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Swatch) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Swatch) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseSwatch(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,21 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwatchStringTemplate(t *testing.T) {
+	assert.Equal(t, "Red (#0)", SwatchRed.String())
+	assert.Equal(t, "Blue (#2)", SwatchBlue.String())
+
+	text, err := SwatchGreen.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "Green (#1)", string(text))
+
+	var swatch Swatch
+	require.NoError(t, swatch.UnmarshalText(text))
+	assert.Equal(t, SwatchGreen, swatch)
+}
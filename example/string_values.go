@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --names
+
+package example
+
+// TicketState is an enumeration of ticket states, written in kebab case on the wire.
+// ENUM(Pending=pending, InProgress=in-progress, Blocked="on hold", Done=done)
+type TicketState int
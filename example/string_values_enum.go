@@ -0,0 +1,91 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TicketState is an enumeration of ticket states, written in kebab case on the wire.
+const (
+	// TicketStatePending is a TicketState of type Pending.
+	TicketStatePending TicketState = iota
+	// TicketStateInProgress is a TicketState of type InProgress.
+	TicketStateInProgress
+	// TicketStateBlocked is a TicketState of type Blocked.
+	TicketStateBlocked
+	// TicketStateDone is a TicketState of type Done.
+	TicketStateDone
+)
+
+const _TicketStateName = "pendingin-progresson holddone"
+
+var _TicketStateNames = []string{
+	_TicketStateName[0:7],
+	_TicketStateName[7:18],
+	_TicketStateName[18:25],
+	_TicketStateName[25:29],
+}
+
+// TicketStateNames returns a list of possible string values of TicketState.
+// The list is built once, and every call returns a copy of it that is safe to modify.
+func TicketStateNames() []string {
+	tmp := make([]string, len(_TicketStateNames))
+	copy(tmp, _TicketStateNames)
+	return tmp
+}
+
+var _TicketStateMap = map[TicketState]string{
+	TicketStatePending:    _TicketStateName[0:7],
+	TicketStateInProgress: _TicketStateName[7:18],
+	TicketStateBlocked:    _TicketStateName[18:25],
+	TicketStateDone:       _TicketStateName[25:29],
+}
+
+// String implements the Stringer interface.
+func (x TicketState) String() string {
+	if str, ok := _TicketStateMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("TicketState(%d)", x)
+}
+
+var _TicketStateValue = map[string]TicketState{
+	_TicketStateName[0:7]:   TicketStatePending,
+	_TicketStateName[7:18]:  TicketStateInProgress,
+	_TicketStateName[18:25]: TicketStateBlocked,
+	_TicketStateName[25:29]: TicketStateDone,
+}
+
+// ParseTicketState attempts to convert a string to a TicketState.
+func ParseTicketState(name string) (TicketState, error) {
+	if x, ok := _TicketStateValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return TicketState(0), fmt.Errorf("%q is not a valid TicketState, try [%s]", name, strings.Join(_TicketStateNames, ", "))
+}
+
+// MarshalText implements the text marshaller method.
+func (x TicketState) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TicketState) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseTicketState(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,27 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketStateStringValues(t *testing.T) {
+	assert.Equal(t, TicketState(0), TicketStatePending)
+	assert.Equal(t, TicketState(1), TicketStateInProgress)
+	assert.Equal(t, TicketState(3), TicketStateDone)
+	assert.Equal(t, "in-progress", TicketStateInProgress.String())
+	assert.Equal(t, "on hold", TicketStateBlocked.String())
+	assert.Equal(t, []string{"pending", "in-progress", "on hold", "done"}, TicketStateNames())
+
+	x, err := ParseTicketState("in-progress")
+	require.NoError(t, err)
+	assert.Equal(t, TicketStateInProgress, x)
+	_, err = ParseTicketState("InProgress")
+	assert.Error(t, err)
+
+	text, err := TicketStateBlocked.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "on hold", string(text))
+}
@@ -0,0 +1,14 @@
+//go:generate ../bin/go-enum -f=$GOFILE --systemaliases
+
+package example
+
+// Paint is a paint color, which the legacy ordering system and the warehouse scanners both spell their own way.
+/*
+ENUM(
+crimson // alias:legacy=RED alias:warehouse=R01,R1
+navy // alias:legacy=BLUE alias:warehouse=B01
+ivory // alias:legacy=WHITE
+scarlet // alias:legacy=RED2 alias:warehouse=R02
+)
+*/
+type Paint int
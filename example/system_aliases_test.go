@@ -0,0 +1,46 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePaintFor(t *testing.T) {
+	tests := map[string]map[string]Paint{
+		"legacy": {
+			"RED":     PaintCrimson,
+			"BLUE":    PaintNavy,
+			"WHITE":   PaintIvory,
+			"RED2":    PaintScarlet,
+			"crimson": PaintCrimson,
+		},
+		"warehouse": {
+			"R01":   PaintCrimson,
+			"R1":    PaintCrimson,
+			"B01":   PaintNavy,
+			"R02":   PaintScarlet,
+			"ivory": PaintIvory,
+		},
+	}
+	for system, names := range tests {
+		for name, expected := range names {
+			x, err := ParsePaintFor(system, name)
+			require.NoError(t, err, "%s %s", system, name)
+			assert.Equal(t, expected, x, "%s %s", system, name)
+		}
+	}
+
+	_, err := ParsePaintFor("warehouse", "RED")
+	assert.EqualError(t, err, "\"RED\" is not a valid Paint", "aliases of other systems are not accepted")
+
+	_, err = ParsePaintFor("legacy", "R01")
+	assert.Error(t, err)
+
+	_, err = ParsePaintFor("mainframe", "crimson")
+	assert.EqualError(t, err, "mainframe is not a system with Paint aliases")
+
+	_, err = ParsePaint("RED")
+	assert.Error(t, err, "aliases are only accepted by ParsePaintFor")
+}
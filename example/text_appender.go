@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --textappender
+
+package example
+
+// ENUM(north, east, south, west)
+type Heading int
@@ -0,0 +1,79 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// HeadingNorth is a Heading of type North.
+	HeadingNorth Heading = iota
+	// HeadingEast is a Heading of type East.
+	HeadingEast
+	// HeadingSouth is a Heading of type South.
+	HeadingSouth
+	// HeadingWest is a Heading of type West.
+	HeadingWest
+)
+
+const _HeadingName = "northeastsouthwest"
+
+var _HeadingMap = map[Heading]string{
+	HeadingNorth: _HeadingName[0:5],
+	HeadingEast:  _HeadingName[5:9],
+	HeadingSouth: _HeadingName[9:14],
+	HeadingWest:  _HeadingName[14:18],
+}
+
+// String implements the Stringer interface.
+func (x Heading) String() string {
+	if str, ok := _HeadingMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Heading(%d)", x)
+}
+
+var _HeadingValue = map[string]Heading{
+	_HeadingName[0:5]:   HeadingNorth,
+	_HeadingName[5:9]:   HeadingEast,
+	_HeadingName[9:14]:  HeadingSouth,
+	_HeadingName[14:18]: HeadingWest,
+}
+
+// ParseHeading attempts to convert a string to a Heading.
+func ParseHeading(name string) (Heading, error) {
+	if x, ok := _HeadingValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Heading(0), fmt.Errorf("%q is not a valid Heading", name)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Heading) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Heading) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseHeading(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText implements the text appender interface.
+func (x Heading) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
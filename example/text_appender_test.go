@@ -0,0 +1,47 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadingAppendText(t *testing.T) {
+	buf := []byte("heading:")
+	for _, x := range []Heading{HeadingNorth, HeadingEast, HeadingSouth, HeadingWest, Heading(99)} {
+		expected, err := x.MarshalText()
+		require.NoError(t, err)
+
+		appended, err := x.AppendText(buf[:len("heading:")])
+		require.NoError(t, err)
+		assert.Equal(t, "heading:"+string(expected), string(appended))
+	}
+}
+
+var headings = []Heading{HeadingNorth, HeadingEast, HeadingSouth, HeadingWest}
+
+func BenchmarkHeadingSerialize(b *testing.B) {
+	b.Run("MarshalText", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]byte, 0, 1024)
+		for i := 0; i < b.N; i++ {
+			buf = buf[:0]
+			for _, x := range headings {
+				text, _ := x.MarshalText()
+				buf = append(buf, text...)
+			}
+		}
+	})
+
+	b.Run("AppendText", func(b *testing.B) {
+		b.ReportAllocs()
+		buf := make([]byte, 0, 1024)
+		for i := 0; i < b.N; i++ {
+			buf = buf[:0]
+			for _, x := range headings {
+				buf, _ = x.AppendText(buf)
+			}
+		}
+	})
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --toml
+
+package example
+
+// Environment is an enumeration of deployment environments, as written in toml config files.
+// ENUM(development, staging, production)
+type Environment int
@@ -0,0 +1,75 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Environment is an enumeration of deployment environments, as written in toml config files.
+const (
+	// EnvironmentDevelopment is a Environment of type Development.
+	EnvironmentDevelopment Environment = iota
+	// EnvironmentStaging is a Environment of type Staging.
+	EnvironmentStaging
+	// EnvironmentProduction is a Environment of type Production.
+	EnvironmentProduction
+)
+
+const _EnvironmentName = "developmentstagingproduction"
+
+var _EnvironmentMap = map[Environment]string{
+	EnvironmentDevelopment: _EnvironmentName[0:11],
+	EnvironmentStaging:     _EnvironmentName[11:18],
+	EnvironmentProduction:  _EnvironmentName[18:28],
+}
+
+// String implements the Stringer interface.
+func (x Environment) String() string {
+	if str, ok := _EnvironmentMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Environment(%d)", x)
+}
+
+var _EnvironmentValue = map[string]Environment{
+	_EnvironmentName[0:11]:  EnvironmentDevelopment,
+	_EnvironmentName[11:18]: EnvironmentStaging,
+	_EnvironmentName[18:28]: EnvironmentProduction,
+}
+
+// ParseEnvironment attempts to convert a string to a Environment.
+func ParseEnvironment(name string) (Environment, error) {
+	if x, ok := _EnvironmentValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Environment(0), fmt.Errorf("%q is not a valid Environment", name)
+}
+
+// MarshalTOML implements the toml marshaller method.
+func (x Environment) MarshalTOML() ([]byte, error) {
+	return []byte(strconv.Quote(x.String())), nil
+}
+
+// UnmarshalTOML implements the toml unmarshaller method.
+func (x *Environment) UnmarshalTOML(v interface{}) error {
+	name, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal %T into Environment, expected a string", v)
+	}
+	tmp, err := ParseEnvironment(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
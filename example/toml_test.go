@@ -0,0 +1,31 @@
+package example
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type deployConfig struct {
+	Env Environment `toml:"env"`
+}
+
+func TestEnvironmentTOML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, toml.NewEncoder(&buf).Encode(deployConfig{Env: EnvironmentStaging}))
+	assert.Equal(t, "env = \"staging\"\n", buf.String())
+
+	var cfg deployConfig
+	_, err := toml.Decode(`env = "production"`, &cfg)
+	require.NoError(t, err)
+	assert.Equal(t, EnvironmentProduction, cfg.Env)
+
+	_, err = toml.Decode(`env = "qa"`, &cfg)
+	assert.ErrorContains(t, err, "\"qa\" is not a valid Environment")
+
+	_, err = toml.Decode(`env = 2`, &cfg)
+	assert.ErrorContains(t, err, "cannot unmarshal int64 into Environment, expected a string")
+}
@@ -0,0 +1,14 @@
+//go:generate ../bin/go-enum -f=$GOFILE --transitions
+
+package example
+
+// Ticket is the state of a support ticket, along with the states it can move on to.
+/*
+ENUM(
+open // next=assigned,closed
+assigned // next=open,resolved
+resolved // next=closed,assigned
+closed
+)
+*/
+type Ticket int
@@ -0,0 +1,75 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Ticket is the state of a support ticket, along with the states it can move on to.
+const (
+	// TicketOpen is a Ticket of type Open.
+	// next=assigned,closed
+	TicketOpen Ticket = iota
+	// TicketAssigned is a Ticket of type Assigned.
+	// next=open,resolved
+	TicketAssigned
+	// TicketResolved is a Ticket of type Resolved.
+	// next=closed,assigned
+	TicketResolved
+	// TicketClosed is a Ticket of type Closed.
+	TicketClosed
+)
+
+const _TicketName = "openassignedresolvedclosed"
+
+var _TicketMap = map[Ticket]string{
+	TicketOpen:     _TicketName[0:4],
+	TicketAssigned: _TicketName[4:12],
+	TicketResolved: _TicketName[12:20],
+	TicketClosed:   _TicketName[20:26],
+}
+
+// String implements the Stringer interface.
+func (x Ticket) String() string {
+	if str, ok := _TicketMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Ticket(%d)", x)
+}
+
+var _TicketValue = map[string]Ticket{
+	_TicketName[0:4]:   TicketOpen,
+	_TicketName[4:12]:  TicketAssigned,
+	_TicketName[12:20]: TicketResolved,
+	_TicketName[20:26]: TicketClosed,
+}
+
+// ParseTicket attempts to convert a string to a Ticket.
+func ParseTicket(name string) (Ticket, error) {
+	if x, ok := _TicketValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Ticket(0), fmt.Errorf("%q is not a valid Ticket", name)
+}
+
+var _TicketTransitions = map[Ticket][]Ticket{
+	TicketOpen:     {TicketAssigned, TicketClosed},
+	TicketAssigned: {TicketOpen, TicketResolved},
+	TicketResolved: {TicketClosed, TicketAssigned},
+}
+
+// AllowedNext returns the values the Ticket can transition to, in the order they are declared.
+// The returned slice is a copy, and is empty for terminal values.
+func (x Ticket) AllowedNext() []Ticket {
+	next := _TicketTransitions[x]
+	return append(make([]Ticket, 0, len(next)), next...)
+}
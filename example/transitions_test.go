@@ -0,0 +1,25 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicketAllowedNext(t *testing.T) {
+	tests := map[Ticket][]Ticket{
+		TicketOpen:     {TicketAssigned, TicketClosed},
+		TicketAssigned: {TicketOpen, TicketResolved},
+		TicketResolved: {TicketClosed, TicketAssigned},
+		TicketClosed:   {},
+		Ticket(42):     {},
+	}
+
+	for x, expected := range tests {
+		assert.Equal(t, expected, x.AllowedNext(), x.String())
+	}
+
+	next := TicketOpen.AllowedNext()
+	next[0] = TicketClosed
+	assert.Equal(t, TicketAssigned, TicketOpen.AllowedNext()[0], "callers must not be able to change the shared transitions")
+}
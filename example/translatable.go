@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --translatable
+
+package example
+
+// ENUM(monday, tuesday, wednesday)
+type Weekday int
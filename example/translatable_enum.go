@@ -0,0 +1,62 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// WeekdayMonday is a Weekday of type Monday.
+	WeekdayMonday Weekday = iota
+	// WeekdayTuesday is a Weekday of type Tuesday.
+	WeekdayTuesday
+	// WeekdayWednesday is a Weekday of type Wednesday.
+	WeekdayWednesday
+)
+
+const _WeekdayName = "mondaytuesdaywednesday"
+
+var _WeekdayMap = map[Weekday]string{
+	WeekdayMonday:    _WeekdayName[0:6],
+	WeekdayTuesday:   _WeekdayName[6:13],
+	WeekdayWednesday: _WeekdayName[13:22],
+}
+
+// String implements the Stringer interface.
+func (x Weekday) String() string {
+	if str, ok := _WeekdayMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Weekday(%d)", x)
+}
+
+var _WeekdayValue = map[string]Weekday{
+	_WeekdayName[0:6]:   WeekdayMonday,
+	_WeekdayName[6:13]:  WeekdayTuesday,
+	_WeekdayName[13:22]: WeekdayWednesday,
+}
+
+// ParseWeekday attempts to convert a string to a Weekday.
+func ParseWeekday(name string) (Weekday, error) {
+	if x, ok := _WeekdayValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Weekday(0), fmt.Errorf("%q is not a valid Weekday", name)
+}
+
+// StringWith returns the translation of the Weekday from translations, or String() if it has none.
+func (x Weekday) StringWith(translations map[Weekday]string) string {
+	if str, ok := translations[x]; ok {
+		return str
+	}
+	return x.String()
+}
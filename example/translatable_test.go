@@ -0,0 +1,21 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekdayStringWith(t *testing.T) {
+	german := map[Weekday]string{
+		WeekdayMonday:  "Montag",
+		WeekdayTuesday: "Dienstag",
+	}
+
+	assert.Equal(t, "Montag", WeekdayMonday.StringWith(german))
+	assert.Equal(t, "Dienstag", WeekdayTuesday.StringWith(german))
+	// Missing translations fall back to the default name.
+	assert.Equal(t, "wednesday", WeekdayWednesday.StringWith(german))
+	assert.Equal(t, "Weekday(7)", Weekday(7).StringWith(german))
+	assert.Equal(t, "monday", WeekdayMonday.StringWith(nil))
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --typedmap
+
+package example
+
+// Suit is a suit of playing cards.
+// ENUM(clubs, diamonds, hearts, spades)
+type Suit int
@@ -0,0 +1,90 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Suit is a suit of playing cards.
+const (
+	// SuitClubs is a Suit of type Clubs.
+	SuitClubs Suit = iota
+	// SuitDiamonds is a Suit of type Diamonds.
+	SuitDiamonds
+	// SuitHearts is a Suit of type Hearts.
+	SuitHearts
+	// SuitSpades is a Suit of type Spades.
+	SuitSpades
+)
+
+const _SuitName = "clubsdiamondsheartsspades"
+
+var _SuitMap = map[Suit]string{
+	SuitClubs:    _SuitName[0:5],
+	SuitDiamonds: _SuitName[5:13],
+	SuitHearts:   _SuitName[13:19],
+	SuitSpades:   _SuitName[19:25],
+}
+
+// String implements the Stringer interface.
+func (x Suit) String() string {
+	if str, ok := _SuitMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Suit(%d)", x)
+}
+
+var _SuitValue = map[string]Suit{
+	_SuitName[0:5]:   SuitClubs,
+	_SuitName[5:13]:  SuitDiamonds,
+	_SuitName[13:19]: SuitHearts,
+	_SuitName[19:25]: SuitSpades,
+}
+
+// ParseSuit attempts to convert a string to a Suit.
+func ParseSuit(name string) (Suit, error) {
+	if x, ok := _SuitValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Suit(0), fmt.Errorf("%q is not a valid Suit", name)
+}
+
+var _SuitMapKeys = []Suit{
+	SuitClubs,
+	SuitDiamonds,
+	SuitHearts,
+	SuitSpades,
+}
+
+// SuitMap is a lookup table keyed by Suit.
+type SuitMap[V any] map[Suit]V
+
+// NewSuitMap returns a SuitMap holding the zero V for every defined Suit.
+func NewSuitMap[V any]() SuitMap[V] {
+	m := make(SuitMap[V], len(_SuitMapKeys))
+	for _, x := range _SuitMapKeys {
+		var zero V
+		m[x] = zero
+	}
+	return m
+}
+
+// Missing returns the defined Suit values that have no entry in the map, in declaration order.
+func (m SuitMap[V]) Missing() []Suit {
+	var missing []Suit
+	for _, x := range _SuitMapKeys {
+		if _, ok := m[x]; !ok {
+			missing = append(missing, x)
+		}
+	}
+	return missing
+}
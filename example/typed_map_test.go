@@ -0,0 +1,26 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuitMap(t *testing.T) {
+	m := NewSuitMap[string]()
+	assert.Len(t, m, 4)
+	for _, x := range []Suit{SuitClubs, SuitDiamonds, SuitHearts, SuitSpades} {
+		v, ok := m[x]
+		assert.True(t, ok, "%s has an entry", x)
+		assert.Equal(t, "", v)
+	}
+	assert.Empty(t, m.Missing())
+
+	m[SuitHearts] = "♥"
+	delete(m, SuitClubs)
+	delete(m, SuitSpades)
+	assert.Equal(t, []Suit{SuitClubs, SuitSpades}, m.Missing())
+
+	literal := SuitMap[int]{SuitDiamonds: 1}
+	assert.Equal(t, []Suit{SuitClubs, SuitHearts, SuitSpades}, literal.Missing())
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 )
 
+// OceanColor is an enumeration of ocean colors that are allowed.
 const (
 	// OceanColorCerulean is a OceanColor of type Cerulean.
 	OceanColorCerulean OceanColor = iota
@@ -46,7 +47,11 @@ func ParseOceanColor(name string) (OceanColor, error) {
 	if x, ok := _OceanColorValue[name]; ok {
 		return x, nil
 	}
-	return OceanColor(0), fmt.Errorf("%s is not a valid OceanColor", name)
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return OceanColor(0), fmt.Errorf("%q is not a valid OceanColor", name)
 }
 
 func ParseOceanColorGlobbedExample() bool {
@@ -0,0 +1,19 @@
+//go:generate ../bin/go-enum -f=$GOFILE --validate
+
+package example
+
+// Workday is a day of the working week, numbered like time.Weekday.
+// ENUM(monday=1, tuesday, wednesday, thursday, friday)
+type Workday uint8
+
+// Verb is a request method, whose values are spread out.
+// ENUM(get, head, post=4, put, delete=10)
+type Verb int
+
+// Balance is a signed contiguous scale.
+// ENUM(negative=-1, neutral, positive)
+type Balance int
+
+// Bit is an unsigned contiguous enum starting at zero.
+// ENUM(off, on)
+type Bit uint
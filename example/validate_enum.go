@@ -0,0 +1,220 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Balance is a signed contiguous scale.
+const (
+	// BalanceNegative is a Balance of type Negative.
+	BalanceNegative Balance = iota + -1
+	// BalanceNeutral is a Balance of type Neutral.
+	BalanceNeutral
+	// BalancePositive is a Balance of type Positive.
+	BalancePositive
+)
+
+const _BalanceName = "negativeneutralpositive"
+
+var _BalanceMap = map[Balance]string{
+	BalanceNegative: _BalanceName[0:8],
+	BalanceNeutral:  _BalanceName[8:15],
+	BalancePositive: _BalanceName[15:23],
+}
+
+// String implements the Stringer interface.
+func (x Balance) String() string {
+	if str, ok := _BalanceMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Balance(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is part of the allowed enumerated values.
+func (x Balance) IsValid() bool {
+	return x >= BalanceNegative && x <= BalancePositive
+}
+
+var _BalanceValue = map[string]Balance{
+	_BalanceName[0:8]:   BalanceNegative,
+	_BalanceName[8:15]:  BalanceNeutral,
+	_BalanceName[15:23]: BalancePositive,
+}
+
+// ParseBalance attempts to convert a string to a Balance.
+func ParseBalance(name string) (Balance, error) {
+	if x, ok := _BalanceValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Balance(0), fmt.Errorf("%q is not a valid Balance", name)
+}
+
+// Bit is an unsigned contiguous enum starting at zero.
+const (
+	// BitOff is a Bit of type Off.
+	BitOff Bit = iota
+	// BitOn is a Bit of type On.
+	BitOn
+)
+
+const _BitName = "offon"
+
+var _BitMap = map[Bit]string{
+	BitOff: _BitName[0:3],
+	BitOn:  _BitName[3:5],
+}
+
+// String implements the Stringer interface.
+func (x Bit) String() string {
+	if str, ok := _BitMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Bit(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is part of the allowed enumerated values.
+func (x Bit) IsValid() bool {
+	return x <= BitOn
+}
+
+var _BitValue = map[string]Bit{
+	_BitName[0:3]: BitOff,
+	_BitName[3:5]: BitOn,
+}
+
+// ParseBit attempts to convert a string to a Bit.
+func ParseBit(name string) (Bit, error) {
+	if x, ok := _BitValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Bit(0), fmt.Errorf("%q is not a valid Bit", name)
+}
+
+// Verb is a request method, whose values are spread out.
+const (
+	// VerbGet is a Verb of type Get.
+	VerbGet Verb = iota
+	// VerbHead is a Verb of type Head.
+	VerbHead
+	// VerbPost is a Verb of type Post.
+	VerbPost Verb = iota + 2
+	// VerbPut is a Verb of type Put.
+	VerbPut
+	// VerbDelete is a Verb of type Delete.
+	VerbDelete Verb = iota + 6
+)
+
+const _VerbName = "getheadpostputdelete"
+
+var _VerbMap = map[Verb]string{
+	VerbGet:    _VerbName[0:3],
+	VerbHead:   _VerbName[3:7],
+	VerbPost:   _VerbName[7:11],
+	VerbPut:    _VerbName[11:14],
+	VerbDelete: _VerbName[14:20],
+}
+
+// String implements the Stringer interface.
+func (x Verb) String() string {
+	if str, ok := _VerbMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Verb(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is part of the allowed enumerated values.
+func (x Verb) IsValid() bool {
+	_, ok := _VerbMap[x]
+	return ok
+}
+
+var _VerbValue = map[string]Verb{
+	_VerbName[0:3]:   VerbGet,
+	_VerbName[3:7]:   VerbHead,
+	_VerbName[7:11]:  VerbPost,
+	_VerbName[11:14]: VerbPut,
+	_VerbName[14:20]: VerbDelete,
+}
+
+// ParseVerb attempts to convert a string to a Verb.
+func ParseVerb(name string) (Verb, error) {
+	if x, ok := _VerbValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Verb(0), fmt.Errorf("%q is not a valid Verb", name)
+}
+
+// Workday is a day of the working week, numbered like time.Weekday.
+const (
+	// WorkdayMonday is a Workday of type Monday.
+	WorkdayMonday Workday = iota + 1
+	// WorkdayTuesday is a Workday of type Tuesday.
+	WorkdayTuesday
+	// WorkdayWednesday is a Workday of type Wednesday.
+	WorkdayWednesday
+	// WorkdayThursday is a Workday of type Thursday.
+	WorkdayThursday
+	// WorkdayFriday is a Workday of type Friday.
+	WorkdayFriday
+)
+
+const _WorkdayName = "mondaytuesdaywednesdaythursdayfriday"
+
+var _WorkdayMap = map[Workday]string{
+	WorkdayMonday:    _WorkdayName[0:6],
+	WorkdayTuesday:   _WorkdayName[6:13],
+	WorkdayWednesday: _WorkdayName[13:22],
+	WorkdayThursday:  _WorkdayName[22:30],
+	WorkdayFriday:    _WorkdayName[30:36],
+}
+
+// String implements the Stringer interface.
+func (x Workday) String() string {
+	if str, ok := _WorkdayMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Workday(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is part of the allowed enumerated values.
+func (x Workday) IsValid() bool {
+	return x >= WorkdayMonday && x <= WorkdayFriday
+}
+
+var _WorkdayValue = map[string]Workday{
+	_WorkdayName[0:6]:   WorkdayMonday,
+	_WorkdayName[6:13]:  WorkdayTuesday,
+	_WorkdayName[13:22]: WorkdayWednesday,
+	_WorkdayName[22:30]: WorkdayThursday,
+	_WorkdayName[30:36]: WorkdayFriday,
+}
+
+// ParseWorkday attempts to convert a string to a Workday.
+func ParseWorkday(name string) (Workday, error) {
+	if x, ok := _WorkdayValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Workday(0), fmt.Errorf("%q is not a valid Workday", name)
+}
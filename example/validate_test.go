@@ -0,0 +1,28 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValid(t *testing.T) {
+	t.Run("contiguous", func(t *testing.T) {
+		for x := Workday(0); x < 8; x++ {
+			assert.Equal(t, x >= 1 && x <= 5, x.IsValid(), "%d", x)
+		}
+		for x := Balance(-3); x < 3; x++ {
+			assert.Equal(t, x >= -1 && x <= 1, x.IsValid(), "%d", x)
+		}
+		assert.True(t, BitOff.IsValid())
+		assert.True(t, BitOn.IsValid())
+		assert.False(t, Bit(2).IsValid())
+	})
+
+	t.Run("sparse", func(t *testing.T) {
+		valid := map[Verb]bool{VerbGet: true, VerbHead: true, VerbPost: true, VerbPut: true, VerbDelete: true}
+		for x := Verb(-1); x < 12; x++ {
+			assert.Equal(t, valid[x], x.IsValid(), "%d", x)
+		}
+	})
+}
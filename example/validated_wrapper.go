@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --validatedwrapper
+
+package example
+
+// Urgency is how soon a support ticket needs an answer.
+// ENUM(low=1, normal, high, urgent=10)
+type Urgency int
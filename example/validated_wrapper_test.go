@@ -0,0 +1,41 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidUrgency(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := NewValidUrgency(UrgencyUrgent)
+		require.NoError(t, err)
+		assert.Equal(t, UrgencyUrgent, v.Urgency())
+		assert.Equal(t, "urgent", v.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, x := range []Urgency{0, 4, 11} {
+			_, err := NewValidUrgency(x)
+			assert.Error(t, err, "%d", x)
+		}
+		_, err := NewValidUrgency(4)
+		assert.EqualError(t, err, "4 is not a valid Urgency")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var s struct {
+			Urgency ValidUrgency `json:"priority"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(`{"priority":"high"}`), &s))
+		assert.Equal(t, UrgencyHigh, s.Urgency.Urgency())
+
+		b, err := json.Marshal(s)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"priority":"high"}`, string(b))
+
+		assert.Error(t, json.Unmarshal([]byte(`{"priority":"whenever"}`), &s))
+	})
+}
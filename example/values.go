@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --values
+
+package example
+
+// Planet is a planet of the solar system, with a placeholder left where Pluto used to be.
+// ENUM(mercury, venus, earth, mars, jupiter, saturn, uranus, neptune, _)
+type Planet int
@@ -0,0 +1,96 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Planet is a planet of the solar system, with a placeholder left where Pluto used to be.
+const (
+	// PlanetMercury is a Planet of type Mercury.
+	PlanetMercury Planet = iota
+	// PlanetVenus is a Planet of type Venus.
+	PlanetVenus
+	// PlanetEarth is a Planet of type Earth.
+	PlanetEarth
+	// PlanetMars is a Planet of type Mars.
+	PlanetMars
+	// PlanetJupiter is a Planet of type Jupiter.
+	PlanetJupiter
+	// PlanetSaturn is a Planet of type Saturn.
+	PlanetSaturn
+	// PlanetUranus is a Planet of type Uranus.
+	PlanetUranus
+	// PlanetNeptune is a Planet of type Neptune.
+	PlanetNeptune
+	// Skipped value.
+	_
+)
+
+const _PlanetName = "mercuryvenusearthmarsjupitersaturnuranusneptune"
+
+var _PlanetMap = map[Planet]string{
+	PlanetMercury: _PlanetName[0:7],
+	PlanetVenus:   _PlanetName[7:12],
+	PlanetEarth:   _PlanetName[12:17],
+	PlanetMars:    _PlanetName[17:21],
+	PlanetJupiter: _PlanetName[21:28],
+	PlanetSaturn:  _PlanetName[28:34],
+	PlanetUranus:  _PlanetName[34:40],
+	PlanetNeptune: _PlanetName[40:47],
+}
+
+// String implements the Stringer interface.
+func (x Planet) String() string {
+	if str, ok := _PlanetMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Planet(%d)", x)
+}
+
+var _PlanetValue = map[string]Planet{
+	_PlanetName[0:7]:   PlanetMercury,
+	_PlanetName[7:12]:  PlanetVenus,
+	_PlanetName[12:17]: PlanetEarth,
+	_PlanetName[17:21]: PlanetMars,
+	_PlanetName[21:28]: PlanetJupiter,
+	_PlanetName[28:34]: PlanetSaturn,
+	_PlanetName[34:40]: PlanetUranus,
+	_PlanetName[40:47]: PlanetNeptune,
+}
+
+// ParsePlanet attempts to convert a string to a Planet.
+func ParsePlanet(name string) (Planet, error) {
+	if x, ok := _PlanetValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Planet(0), fmt.Errorf("%q is not a valid Planet", name)
+}
+
+var _PlanetValues = []Planet{
+	PlanetMercury,
+	PlanetVenus,
+	PlanetEarth,
+	PlanetMars,
+	PlanetJupiter,
+	PlanetSaturn,
+	PlanetUranus,
+	PlanetNeptune,
+}
+
+// PlanetValues returns a list of the values of Planet.
+// The list is built once, and every call returns a copy of it that is safe to modify.
+func PlanetValues() []Planet {
+	tmp := make([]Planet, len(_PlanetValues))
+	copy(tmp, _PlanetValues)
+	return tmp
+}
@@ -0,0 +1,25 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanetValues(t *testing.T) {
+	expected := []Planet{
+		PlanetMercury,
+		PlanetVenus,
+		PlanetEarth,
+		PlanetMars,
+		PlanetJupiter,
+		PlanetSaturn,
+		PlanetUranus,
+		PlanetNeptune,
+	}
+	assert.Equal(t, expected, PlanetValues())
+
+	values := PlanetValues()
+	values[0] = PlanetNeptune
+	assert.Equal(t, PlanetMercury, PlanetValues()[0], "callers must not be able to change the shared list")
+}
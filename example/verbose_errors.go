@@ -0,0 +1,6 @@
+//go:generate ../bin/go-enum -f=$GOFILE --verboseerrors --marshal
+
+package example
+
+// ENUM(json, yaml, toml)
+type ConfigFormat int
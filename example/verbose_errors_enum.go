@@ -0,0 +1,77 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ConfigFormatJson is a ConfigFormat of type Json.
+	ConfigFormatJson ConfigFormat = iota
+	// ConfigFormatYaml is a ConfigFormat of type Yaml.
+	ConfigFormatYaml
+	// ConfigFormatToml is a ConfigFormat of type Toml.
+	ConfigFormatToml
+)
+
+const _ConfigFormatName = "jsonyamltoml"
+
+var _ConfigFormatNames = []string{
+	_ConfigFormatName[0:4],
+	_ConfigFormatName[4:8],
+	_ConfigFormatName[8:12],
+}
+
+var _ConfigFormatMap = map[ConfigFormat]string{
+	ConfigFormatJson: _ConfigFormatName[0:4],
+	ConfigFormatYaml: _ConfigFormatName[4:8],
+	ConfigFormatToml: _ConfigFormatName[8:12],
+}
+
+// String implements the Stringer interface.
+func (x ConfigFormat) String() string {
+	if str, ok := _ConfigFormatMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ConfigFormat(%d)", x)
+}
+
+var _ConfigFormatValue = map[string]ConfigFormat{
+	_ConfigFormatName[0:4]:  ConfigFormatJson,
+	_ConfigFormatName[4:8]:  ConfigFormatYaml,
+	_ConfigFormatName[8:12]: ConfigFormatToml,
+}
+
+// ParseConfigFormat attempts to convert a string to a ConfigFormat.
+func ParseConfigFormat(name string) (ConfigFormat, error) {
+	if x, ok := _ConfigFormatValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return ConfigFormat(0), fmt.Errorf("%q is not a valid ConfigFormat, try [%s]", name, strings.Join(_ConfigFormatNames, ", "))
+}
+
+// MarshalText implements the text marshaller method.
+func (x ConfigFormat) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ConfigFormat) UnmarshalText(text []byte) error {
+	name := string(text)
+	tmp, err := ParseConfigFormat(name)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
@@ -0,0 +1,34 @@
+package example
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFormatVerboseErrors(t *testing.T) {
+	var cfg struct {
+		Format ConfigFormat `json:"format"`
+	}
+
+	err := json.Unmarshal([]byte(`{"format":"xml"}`), &cfg)
+	assert.EqualError(t, err, `"xml" is not a valid ConfigFormat, try [json, yaml, toml]`)
+
+	long := strings.Repeat("x", 100)
+	err = json.Unmarshal([]byte(`{"format":"`+long+`"}`), &cfg)
+	assert.EqualError(t, err, `"`+long[:64]+`..." is not a valid ConfigFormat, try [json, yaml, toml]`)
+
+	runes := strings.Repeat("é", 100)
+	err = json.Unmarshal([]byte(`{"format":"`+runes+`"}`), &cfg)
+	assert.EqualError(t, err, `"`+strings.Repeat("é", 64)+`..." is not a valid ConfigFormat, try [json, yaml, toml]`, "characters are not split")
+}
+
+func TestDefaultErrorsTruncated(t *testing.T) {
+	_, err := ParseShipment("lost\nin transit")
+	assert.EqualError(t, err, `"lost\nin transit" is not a valid Shipment`, "the input is quoted")
+
+	_, err = ParseShipment(strings.Repeat("ü", 80))
+	assert.EqualError(t, err, `"`+strings.Repeat("ü", 64)+`..." is not a valid Shipment`)
+}
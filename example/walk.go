@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --walk
+
+package example
+
+// Register is a device register, with reserved addresses left between them.
+// ENUM(status, _, control, _, _, data=8, _=12, checksum)
+type Register uint8
@@ -0,0 +1,91 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+// Register is a device register, with reserved addresses left between them.
+const (
+	// RegisterStatus is a Register of type Status.
+	RegisterStatus Register = iota
+	// Skipped value.
+	_
+	// RegisterControl is a Register of type Control.
+	RegisterControl
+	// Skipped value.
+	_
+	// Skipped value.
+	_
+	// RegisterData is a Register of type Data.
+	RegisterData Register = iota + 3
+	// Skipped value.
+	_ Register = iota + 6
+	// RegisterChecksum is a Register of type Checksum.
+	RegisterChecksum
+)
+
+const _RegisterName = "statuscontroldatachecksum"
+
+var _RegisterMap = map[Register]string{
+	RegisterStatus:   _RegisterName[0:6],
+	RegisterControl:  _RegisterName[6:13],
+	RegisterData:     _RegisterName[13:17],
+	RegisterChecksum: _RegisterName[17:25],
+}
+
+// String implements the Stringer interface.
+func (x Register) String() string {
+	if str, ok := _RegisterMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Register(%d)", x)
+}
+
+var _RegisterValue = map[string]Register{
+	_RegisterName[0:6]:   RegisterStatus,
+	_RegisterName[6:13]:  RegisterControl,
+	_RegisterName[13:17]: RegisterData,
+	_RegisterName[17:25]: RegisterChecksum,
+}
+
+// ParseRegister attempts to convert a string to a Register.
+func ParseRegister(name string) (Register, error) {
+	if x, ok := _RegisterValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Register(0), fmt.Errorf("%q is not a valid Register", name)
+}
+
+// _RegisterSlots holds every declared slot of Register, including the skipped ones.
+var _RegisterSlots = []struct {
+	value   uint8
+	name    string
+	defined bool
+}{
+	{0, "status", true},
+	{1, "", false},
+	{2, "control", true},
+	{3, "", false},
+	{4, "", false},
+	{8, "data", true},
+	{12, "", false},
+	{13, "checksum", true},
+}
+
+// RegisterWalk calls fn for every declared slot of Register in declaration order, including the skipped (_) slots.
+// Skipped slots are passed with an empty name and defined set to false.
+func RegisterWalk(fn func(value uint8, name string, defined bool)) {
+	for _, slot := range _RegisterSlots {
+		fn(slot.value, slot.name, slot.defined)
+	}
+}
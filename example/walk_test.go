@@ -0,0 +1,36 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterWalk(t *testing.T) {
+	type slot struct {
+		value   uint8
+		name    string
+		defined bool
+	}
+	var slots []slot
+	RegisterWalk(func(value uint8, name string, defined bool) {
+		slots = append(slots, slot{value, name, defined})
+	})
+
+	assert.Equal(t, []slot{
+		{0, "status", true},
+		{1, "", false},
+		{2, "control", true},
+		{3, "", false},
+		{4, "", false},
+		{8, "data", true},
+		{12, "", false},
+		{13, "checksum", true},
+	}, slots)
+
+	for _, s := range slots {
+		if s.defined {
+			assert.Equal(t, s.name, Register(s.value).String())
+		}
+	}
+}
@@ -0,0 +1,14 @@
+//go:generate ../bin/go-enum -f=$GOFILE --weights
+
+package example
+
+// Rarity is an enumeration of loot rarities, weighted by how often they drop.
+/*
+ENUM(
+common // weight=6
+uncommon // Dropped by elite enemies weight=3
+_
+rare
+)
+*/
+type Rarity int
@@ -0,0 +1,90 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// Rarity is an enumeration of loot rarities, weighted by how often they drop.
+const (
+	// RarityCommon is a Rarity of type Common.
+	// weight=6
+	RarityCommon Rarity = iota
+	// RarityUncommon is a Rarity of type Uncommon.
+	// Dropped by elite enemies weight=3
+	RarityUncommon
+	// Skipped value.
+	_
+	// RarityRare is a Rarity of type Rare.
+	RarityRare
+)
+
+const _RarityName = "commonuncommonrare"
+
+var _RarityMap = map[Rarity]string{
+	RarityCommon:   _RarityName[0:6],
+	RarityUncommon: _RarityName[6:14],
+	RarityRare:     _RarityName[14:18],
+}
+
+// String implements the Stringer interface.
+func (x Rarity) String() string {
+	if str, ok := _RarityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Rarity(%d)", x)
+}
+
+var _RarityValue = map[string]Rarity{
+	_RarityName[0:6]:   RarityCommon,
+	_RarityName[6:14]:  RarityUncommon,
+	_RarityName[14:18]: RarityRare,
+}
+
+// ParseRarity attempts to convert a string to a Rarity.
+func ParseRarity(name string) (Rarity, error) {
+	if x, ok := _RarityValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Rarity(0), fmt.Errorf("%q is not a valid Rarity", name)
+}
+
+var _RarityWeights = map[Rarity]int{
+	RarityCommon:   6,
+	RarityUncommon: 3,
+	RarityRare:     1,
+}
+
+var _RarityWeightedValues = []Rarity{
+	RarityCommon,
+	RarityUncommon,
+	RarityRare,
+}
+
+var _RarityCumulativeWeights = []int{
+	6,
+	9,
+	10,
+}
+
+// Weight returns the weight declared for the Rarity, or 0 if it is not a defined value.
+func (x Rarity) Weight() int {
+	return _RarityWeights[x]
+}
+
+// RarityWeightedRandom returns a random Rarity, where each value is picked proportionally to its weight.
+func RarityWeightedRandom(r *rand.Rand) Rarity {
+	n := r.Intn(_RarityCumulativeWeights[len(_RarityCumulativeWeights)-1])
+	return _RarityWeightedValues[sort.SearchInts(_RarityCumulativeWeights, n+1)]
+}
@@ -0,0 +1,30 @@
+package example
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRarityWeight(t *testing.T) {
+	assert.Equal(t, 6, RarityCommon.Weight())
+	assert.Equal(t, 3, RarityUncommon.Weight())
+	assert.Equal(t, 1, RarityRare.Weight())
+	assert.Equal(t, 0, Rarity(2).Weight())
+}
+
+func TestRarityWeightedRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	counts := map[Rarity]int{}
+	const draws = 10000
+	for i := 0; i < draws; i++ {
+		counts[RarityWeightedRandom(r)]++
+	}
+
+	// The skipped value is never picked, and the rest should land near 6:3:1.
+	assert.Len(t, counts, 3)
+	assert.InDelta(t, 0.6, float64(counts[RarityCommon])/draws, 0.03)
+	assert.InDelta(t, 0.3, float64(counts[RarityUncommon])/draws, 0.03)
+	assert.InDelta(t, 0.1, float64(counts[RarityRare])/draws, 0.03)
+}
@@ -0,0 +1,10 @@
+//go:generate ../bin/go-enum -f=$GOFILE --xml
+
+package example
+
+// OrderState is always written as a <status> element.
+// ENUM(open, paid, shipped) xmlName=status
+type OrderState int
+
+// ENUM(standard, express)
+type DeliverySpeed int
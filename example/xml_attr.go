@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --xml --forcelower
+
+package example
+
+// Alignment is an enumeration of text alignments, written as lower case xml attributes.
+// ENUM(Left, Center, Right)
+type Alignment int
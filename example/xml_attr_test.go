@@ -0,0 +1,33 @@
+package example
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type xmlParagraph struct {
+	XMLName xml.Name  `xml:"p"`
+	Align   Alignment `xml:"align,attr"`
+	Text    string    `xml:",chardata"`
+}
+
+func TestAlignmentXMLAttr(t *testing.T) {
+	b, err := xml.Marshal(xmlParagraph{Align: AlignmentCenter, Text: "hi"})
+	require.NoError(t, err)
+	assert.Equal(t, `<p align="center">hi</p>`, string(b))
+
+	var p xmlParagraph
+	require.NoError(t, xml.Unmarshal([]byte(`<p align="right">hi</p>`), &p))
+	assert.Equal(t, AlignmentRight, p.Align)
+
+	assert.EqualError(t, xml.Unmarshal([]byte(`<p align="justify">hi</p>`), &p), "\"justify\" is not a valid Alignment")
+}
+
+func TestAlignmentXMLElement(t *testing.T) {
+	b, err := xml.Marshal(AlignmentLeft)
+	require.NoError(t, err)
+	assert.Equal(t, "<Alignment>left</Alignment>", string(b))
+}
@@ -0,0 +1,39 @@
+package example
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type xmlOrder struct {
+	XMLName xml.Name      `xml:"order"`
+	State   OrderState    `xml:"state"`
+	Speed   DeliverySpeed `xml:"speed"`
+}
+
+func TestOrderStateXMLName(t *testing.T) {
+	b, err := xml.Marshal(OrderStatePaid)
+	require.NoError(t, err)
+	assert.Equal(t, "<status>paid</status>", string(b))
+
+	// Without a directive, the type name is used at the top level, and field names within structs.
+	b, err = xml.Marshal(DeliverySpeedExpress)
+	require.NoError(t, err)
+	assert.Equal(t, "<DeliverySpeed>express</DeliverySpeed>", string(b))
+
+	b, err = xml.Marshal(xmlOrder{State: OrderStateShipped, Speed: DeliverySpeedStandard})
+	require.NoError(t, err)
+	assert.Equal(t, "<order><status>shipped</status><speed>standard</speed></order>", string(b))
+}
+
+func TestOrderStateUnmarshalXML(t *testing.T) {
+	var x OrderState
+	require.NoError(t, xml.Unmarshal([]byte("<status>shipped</status>"), &x))
+	assert.Equal(t, OrderStateShipped, x)
+
+	var speed DeliverySpeed
+	assert.EqualError(t, xml.Unmarshal([]byte("<DeliverySpeed>overnight</DeliverySpeed>"), &speed), "\"overnight\" is not a valid DeliverySpeed")
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum -f=$GOFILE --marshal --yaml --nocase
+
+package example
+
+// Verbosity is an enumeration of verbosity levels, as read from yaml config files.
+// ENUM(quiet, normal, verbose, trace)
+type Verbosity int
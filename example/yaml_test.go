@@ -0,0 +1,29 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type verbosityConfig struct {
+	Level Verbosity `yaml:"level"`
+}
+
+func TestVerbosityYAML(t *testing.T) {
+	b, err := yaml.Marshal(verbosityConfig{Level: VerbosityVerbose})
+	require.NoError(t, err)
+	assert.Equal(t, "level: verbose\n", string(b))
+
+	var cfg verbosityConfig
+	require.NoError(t, yaml.Unmarshal([]byte("level: TRACE\n"), &cfg))
+	assert.Equal(t, VerbosityTrace, cfg.Level)
+
+	err = yaml.Unmarshal([]byte("level: loud\n"), &cfg)
+	require.Error(t, err)
+	var level Verbosity
+	textErr := level.UnmarshalText([]byte("loud"))
+	assert.Equal(t, textErr.Error(), err.Error())
+}
@@ -0,0 +1,9 @@
+//go:generate ../bin/go-enum -f=$GOFILE --zero
+
+package example
+
+// ENUM(unset, low, medium, high)
+type Priority int
+
+// ENUM(one=1, two, three)
+type NoZeroPriority int
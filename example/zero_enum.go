@@ -0,0 +1,121 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+package example
+
+import (
+	"fmt"
+)
+
+const (
+	// NoZeroPriorityOne is a NoZeroPriority of type One.
+	NoZeroPriorityOne NoZeroPriority = iota + 1
+	// NoZeroPriorityTwo is a NoZeroPriority of type Two.
+	NoZeroPriorityTwo
+	// NoZeroPriorityThree is a NoZeroPriority of type Three.
+	NoZeroPriorityThree
+)
+
+const _NoZeroPriorityName = "onetwothree"
+
+var _NoZeroPriorityMap = map[NoZeroPriority]string{
+	NoZeroPriorityOne:   _NoZeroPriorityName[0:3],
+	NoZeroPriorityTwo:   _NoZeroPriorityName[3:6],
+	NoZeroPriorityThree: _NoZeroPriorityName[6:11],
+}
+
+// String implements the Stringer interface.
+func (x NoZeroPriority) String() string {
+	if str, ok := _NoZeroPriorityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("NoZeroPriority(%d)", x)
+}
+
+var _NoZeroPriorityValue = map[string]NoZeroPriority{
+	_NoZeroPriorityName[0:3]:  NoZeroPriorityOne,
+	_NoZeroPriorityName[3:6]:  NoZeroPriorityTwo,
+	_NoZeroPriorityName[6:11]: NoZeroPriorityThree,
+}
+
+// ParseNoZeroPriority attempts to convert a string to a NoZeroPriority.
+func ParseNoZeroPriority(name string) (NoZeroPriority, error) {
+	if x, ok := _NoZeroPriorityValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return NoZeroPriority(0), fmt.Errorf("%q is not a valid NoZeroPriority", name)
+}
+
+// NoZeroPriorityZero returns the zero value of NoZeroPriority.
+func NoZeroPriorityZero() NoZeroPriority {
+	return NoZeroPriority(0)
+}
+
+// IsZero reports whether the NoZeroPriority is the zero value.
+func (x NoZeroPriority) IsZero() bool {
+	return x == NoZeroPriorityZero()
+}
+
+const (
+	// PriorityUnset is a Priority of type Unset.
+	PriorityUnset Priority = iota
+	// PriorityLow is a Priority of type Low.
+	PriorityLow
+	// PriorityMedium is a Priority of type Medium.
+	PriorityMedium
+	// PriorityHigh is a Priority of type High.
+	PriorityHigh
+)
+
+const _PriorityName = "unsetlowmediumhigh"
+
+var _PriorityMap = map[Priority]string{
+	PriorityUnset:  _PriorityName[0:5],
+	PriorityLow:    _PriorityName[5:8],
+	PriorityMedium: _PriorityName[8:14],
+	PriorityHigh:   _PriorityName[14:18],
+}
+
+// String implements the Stringer interface.
+func (x Priority) String() string {
+	if str, ok := _PriorityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Priority(%d)", x)
+}
+
+var _PriorityValue = map[string]Priority{
+	_PriorityName[0:5]:   PriorityUnset,
+	_PriorityName[5:8]:   PriorityLow,
+	_PriorityName[8:14]:  PriorityMedium,
+	_PriorityName[14:18]: PriorityHigh,
+}
+
+// ParsePriority attempts to convert a string to a Priority.
+func ParsePriority(name string) (Priority, error) {
+	if x, ok := _PriorityValue[name]; ok {
+		return x, nil
+	}
+	// Keep errors readable when a long document ends up in the input, without splitting a character.
+	if runes := []rune(name); len(runes) > 64 {
+		name = string(runes[:64]) + "..."
+	}
+	return Priority(0), fmt.Errorf("%q is not a valid Priority", name)
+}
+
+// PriorityZero returns the zero value of Priority.
+func PriorityZero() Priority {
+	return Priority(0)
+}
+
+// IsZero reports whether the Priority is the zero value.
+func (x Priority) IsZero() bool {
+	return x == PriorityZero()
+}
@@ -0,0 +1,20 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityZero(t *testing.T) {
+	assert.Equal(t, PriorityUnset, PriorityZero())
+	assert.True(t, PriorityUnset.IsZero())
+	assert.False(t, PriorityHigh.IsZero())
+
+	var x Priority
+	assert.True(t, x.IsZero())
+
+	assert.Equal(t, NoZeroPriority(0), NoZeroPriorityZero())
+	assert.True(t, NoZeroPriorityZero().IsZero())
+	assert.False(t, NoZeroPriorityOne.IsZero())
+}
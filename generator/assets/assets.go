@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// enum.tmpl (8.705kB)
+// enum.tmpl (54.899kB)
 
 package assets
 
@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _enumTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x59\x5f\x8f\xdb\xb8\x11\x7f\xb6\x3e\xc5\x9c\x90\xbb\x93\x5c\x47\x4e\xd1\xa2\x0f\x39\xec\xc3\xe5\x4f\x83\x3b\xf4\x36\x41\x37\xcd\x4b\x10\x04\x5c\x69\xb4\xe6\xad\x44\xea\x48\xca\x2b\x57\xd5\x77\x2f\x86\xa4\x64\x59\x96\x77\xb7\xe9\xee\x05\x45\x5f\x0c\x53\x1c\x0e\xe7\x37\x33\x9c\x3f\x64\xdb\x3e\x85\x0c\x73\x2e\x10\xc2\x0d\xb2\x0c\x55\xd8\x75\xc1\x7a\x0d\x2f\x65\x86\x70\x85\x02\x15\x33\x98\xc1\xe5\x0e\xae\xe4\x53\x14\x75\x09\xaf\xde\xc2\xf9\xdb\xf7\xf0\xfa\xd5\x4f\xef\x13\xa2\xfc\x80\x4a\x73\x29\x9e\x43\xdb\x42\xb2\x75\x03\x70\x4c\xfe\x8e\x5b\xbe\x9f\x53\x7e\xe4\x27\x5f\xd4\xbc\xc8\xe0\x15\x33\xe8\xa6\x2f\x69\x4c\xc3\xd1\xbc\x81\x17\xbb\xfd\xac\x79\xb1\xa3\xb9\xa0\x62\xe9\x35\xbb\x42\x68\xdb\xc4\xff\xa5\xaf\xbc\xac\xa4\x32\x10\x05\x00\x00\x61\x5e\x9a\x30\x88\x83\xb6\x45\x91\xc1\x53\x9a\x1f\x43\x25\x20\x04\x34\x95\x42\xd3\x12\x9a\x7b\x42\x1f\xcf\x59\x89\xf0\xfc\x0c\x12\x1a\x24\x76\x44\x8b\x87\xf9\xf7\xbb\x6a\x34\x6f\x47\xc3\xfc\x96\x29\x4d\x73\x19\x4f\x0d\x84\x05\xd3\x46\xe6\xb9\x46\x13\x42\xf8\x2c\xf4\x64\xa0\x98\xb8\x42\x78\xa2\x7e\x12\x19\x36\x2b\x5a\x54\xd4\x23\x8e\x1f\x68\xa8\x09\xe5\xc2\xf2\x24\x2e\x6f\x2d\x17\xa2\xa9\x8a\x3a\xbd\x3e\x64\xed\x76\xfd\x17\xe4\x5c\x69\x03\x5d\xd7\xb6\xf0\x44\x0e\x0b\xfc\x3f\xbf\xdd\x08\x82\xdf\xd7\xed\x03\x3c\x07\xfc\xcd\x7f\x73\xa0\xc3\xcf\x61\xd7\xad\xd7\x70\x71\xcd\xab\x0a\x33\x70\x53\x6d\x8b\x85\x46\x3b\xd1\xb6\x9e\xfc\x9d\xc2\x9c\x37\x98\xd1\xb2\xae\x03\xae\x81\xd1\x64\xaf\xcc\xae\x03\x99\x83\xa1\x3d\x87\x25\xee\x7b\x62\x6d\xd3\x23\xe5\x79\xbf\xff\x4b\x59\x96\x28\x0c\x4d\x8c\xf7\x19\x7d\x26\x7a\xb7\x94\x4c\x7d\x4a\x92\x3d\x2e\x8f\xfe\x99\x55\xcf\x58\xb2\x33\xe0\xd2\x30\x47\x48\x6e\xf1\x2c\x1c\x94\xd7\x75\xf0\x07\x18\x29\x93\x96\xda\x3d\x9d\x0e\xfc\x8a\xb1\x7d\xc6\x94\xc7\x9b\x9c\xe4\xf6\xe4\x33\x19\xca\x32\xb0\xa6\x3c\xb4\xae\xfb\xe3\x3d\xcc\x61\x8e\xc9\x95\xc1\x60\x59\x15\x74\x58\x42\x6d\x14\x17\x57\xa8\x42\x48\xec\xe9\xd8\x32\x05\x9f\xdb\x76\xef\xc1\x5d\xf7\x0b\xab\xe0\x8c\xf6\x2f\x59\xc5\xf3\x9d\xf3\x35\x4b\x4c\x26\xb6\xeb\x81\x97\x55\x81\xa4\x61\x0d\x66\x83\xfe\x2b\x2a\xe0\xc2\xa0\xca\x59\x8a\x49\x90\xd7\x22\x85\xa8\x81\x43\xe6\xb1\xa7\x8d\x62\x70\xa2\x40\x1b\x2c\x78\x4e\x83\x15\xc8\x6b\x42\x77\x2c\xce\xc7\xe6\xd3\x0f\x34\xd9\x06\x8b\x85\x42\x53\x2b\x41\xf4\xc1\xa2\x0b\xfa\x61\x5e\x9a\xe4\xa2\x52\x5c\x98\x3c\x0a\x0f\xd7\x47\xdf\x66\x71\xb8\x82\x26\x0e\xe6\xe1\xda\x43\xe4\x00\xd7\xe2\x00\x72\x52\xc8\x1b\x54\x29\xd3\xd8\xa3\x7f\xc7\x94\xc6\xc3\xe5\xc0\x0c\x69\x97\x14\x21\x21\x95\x62\x8b\xca\x00\xeb\xc1\x19\x69\x1d\x7c\xbc\xc0\x6b\x66\x86\x55\x24\xe8\x30\xb9\x95\x31\x44\x87\x93\x2b\x40\xa5\xa4\x8a\xbd\xbe\x9a\x13\xda\xb2\x68\x3e\x12\xa3\x23\x95\x35\x2b\x10\xbc\x08\x16\x5d\xdb\xf2\x1c\x12\x21\x7b\x64\x74\x72\x5e\xd2\x7f\x2e\x34\x0a\xcd\x0d\xdf\x22\x54\x24\xdf\x0a\x32\x02\xa0\xb1\x62\x14\xd9\xa1\x90\xf2\xba\xae\x08\x55\xa5\x70\x8b\xc2\x40\x2d\x04\xa6\xa8\x35\x53\x3b\x48\xa5\x36\x74\x7e\x7b\xb5\x91\x02\x06\x4d\xf0\x1c\x6e\x10\x32\x29\xbe\x37\x20\x10\x33\x30\x32\xb9\x07\x12\xb7\x5a\x27\xef\xe5\xdf\x88\xab\x55\x51\x7c\x1b\xb4\xde\xf1\x17\x1e\x25\x2b\x51\xdb\x70\xda\xd3\x4e\x74\xfe\x2c\x5e\x59\xef\x79\x4d\xda\xcd\xa3\xf0\x5b\x4d\x51\x49\x48\x32\xe2\x96\x15\x3c\x83\xa9\x1d\x8c\xda\xc1\xc7\x6f\xf5\xa7\x70\x05\xc4\x7d\x05\xbd\x8c\x3f\x4b\x2e\xa2\x09\x0a\xfa\xd5\x2b\x08\x57\x10\xc6\xb1\x0f\x46\x14\x11\x1e\x50\x22\x2f\x47\x3c\x0e\x75\x36\x83\x91\xce\x93\xb2\xd6\xc6\xda\xd2\x27\xca\x5f\x6a\x6d\xe6\xdc\xd8\xbb\xae\xbe\xd5\x77\x57\xc0\x44\x06\x15\x13\x3c\xd5\xc4\xdd\xcb\x65\xa5\xf2\x7e\x7d\x82\xff\xa1\x6f\x4f\xf6\x6e\x83\xc5\x96\x15\xd6\xc3\xc9\x11\x4e\x2d\x8f\xad\xbf\x10\xd1\x37\x67\x64\x6f\xeb\x01\x56\x98\x08\x95\x8a\xc7\xf1\x60\xcb\x8a\xa0\x0b\xfa\x9c\xd1\xeb\xa2\x32\x8a\xb4\x70\x2a\x32\xbd\x33\x2a\x8a\x61\x79\x2c\x9c\x67\xfa\x5d\x33\xc3\xb3\x64\x4a\x6f\x58\xd1\x6b\xd7\x8d\xde\x63\x63\xa6\x31\xd2\xd0\x37\x4f\x5d\xa0\x82\x12\xcd\x46\x66\xa7\xe3\xe4\x88\x55\x14\x43\xf4\xf1\xd3\xe5\xce\xe0\x38\x0a\x78\xa9\xdc\x44\xd4\x24\x7d\x60\x8d\xdd\x61\x70\x11\xeb\x1f\xa2\xbc\x43\xa4\x5a\xdc\x22\xd4\x72\x2a\xd5\x01\xbf\xc8\x32\x70\x02\xc4\x4e\x32\x12\x4c\xf8\xb2\xc8\x59\xdb\x12\xc5\xc1\xc2\x94\xd5\x97\x59\xd8\xe3\x44\xe5\x22\xfe\xb2\x81\x33\x30\x65\x35\x28\xc0\x81\x9d\xd8\x45\x2a\x48\xf4\x6f\x85\xfd\x11\x75\x51\x70\x61\x86\xff\xda\xa8\xae\x9b\x4b\x05\xaf\x95\x3a\xe7\xc5\x3b\xa3\xe0\xcc\xa1\xd1\xc9\x39\xde\x44\xa1\x2b\x7f\x2a\x69\xb3\x9b\x75\x7a\x5e\x84\x31\xac\xd7\x20\x05\x42\x85\xca\x95\x2c\xb9\x54\xd0\x57\x9b\x69\xc1\xf4\x06\xb5\xcb\x99\x29\x13\x47\x19\x33\x65\x42\xcc\x27\xcc\x23\x9d\x13\x6d\xe4\x64\x18\xc8\xdb\x2e\x06\xf2\xfa\xc3\xac\xe0\x88\xce\xf6\xba\xb3\xca\x3a\x8a\x2f\x83\x52\x49\xa1\x36\xfc\xff\x08\x37\x3c\x43\xe5\x0b\x4e\x99\x83\x26\xf9\xd8\x65\x81\x16\x9a\x4e\x2c\x55\xa6\xf8\x16\x95\x2b\x3b\xdd\x5e\x1a\x98\x71\xae\x24\x2b\x5b\xba\x6d\x10\x0a\xae\x8d\xd5\x05\x36\x15\x66\x1c\x45\xba\x0b\x16\xfa\x86\x9b\x74\x03\x5b\xb2\xbe\x2b\xc0\x22\x62\x6c\x05\x4f\x5d\xee\x31\x7f\xf9\xf3\xf3\x13\x22\x6f\x63\x4f\xe5\x5c\xca\x91\x39\x6f\x9a\x77\xa6\x6d\xec\xc2\xff\xc8\xfa\x14\x70\x67\xbc\x8b\x70\x51\x44\xa7\x18\x69\xa3\xde\xc6\x69\x99\xaa\x19\xa7\x4e\xb6\x8f\x89\x44\xef\xd4\xbc\x82\xad\xf7\x65\x6d\x14\xc5\xce\xe4\x47\x23\x79\xb4\x8d\x7f\x70\x13\x23\x1b\x8c\x65\x9d\x8a\xc9\x0a\x7f\x5a\x17\x0b\xf2\xed\x45\x37\x94\xb9\x16\xae\x3b\x5a\x77\xc3\xf5\x27\x6d\x1b\x7f\x25\xd8\xfb\xfd\x1f\x14\xfe\x21\xf9\xe0\x1c\xdb\xbd\xcb\xdc\xe9\x30\xcb\x63\x1e\x84\xc4\x0b\x68\xe5\xf3\x61\xe4\x54\x2c\x08\x9c\x68\x76\x97\x65\xbf\x75\x7d\x9f\xbd\xeb\xfb\xf9\xf4\xd2\xf3\xfa\x2f\xe4\x9a\xb0\x5e\x1e\xf0\x76\x22\x3c\x06\xf7\xbc\x90\x8c\xd8\x53\x24\xfc\x55\x4b\xd1\xa7\x37\x0d\xb8\x45\xb5\x33\x1b\x5b\x01\x92\x1f\x79\x4a\x5b\x33\x98\xef\xe9\x8b\xa8\xcb\x4b\x54\x77\xea\xe6\x41\xb6\x78\x14\xcd\xd6\x8f\x69\xb6\xfa\x51\xed\xb6\xdc\x87\xd1\x2f\x65\x7f\x5b\x34\x5a\x7e\xad\xe8\xbb\x7c\xb8\xf0\xdb\x05\x8b\xa1\xc0\x08\x4e\x56\x15\xda\x15\x94\xeb\x35\xb8\x9c\x38\x49\xf2\x2e\x5f\xba\xb9\x7b\xf5\xc6\x96\x92\xaa\xbd\x71\xa6\x9d\xa9\xf9\xf6\xc5\xde\x6a\x28\x7f\x5c\x5f\xf1\x35\xa4\xb1\xbe\x1a\x35\x23\x59\x86\x52\xcc\xff\xe9\x8b\xe5\xbc\x60\x57\x5e\xc4\x0b\x3c\x2a\x47\xdf\xc8\x82\x89\x2b\x20\x22\x5f\x63\x0c\x42\x02\xc9\x78\x5b\x89\x84\x86\xac\x39\x34\x18\x43\x2d\xba\xbd\xb5\xe6\x24\x07\x08\x86\xa4\x32\x2a\x34\x5d\xf1\xfc\xe6\x76\x19\xdf\xa0\x31\x63\x4d\xde\x25\xe4\x1b\xa4\x4a\x7e\x54\xc2\x8d\x74\xb8\x6c\xfc\x9e\xf6\xc2\x6d\xb2\xe9\x15\x37\x9b\xfa\x32\x49\x65\xb9\xd6\x55\xfe\xc7\x3f\xad\xab\xbf\x92\x22\x27\x3a\xba\x65\x67\x62\x7a\x70\xe3\xe2\x77\x9d\x5c\x95\x84\x27\xeb\xe8\x99\x12\x9a\xec\x68\xcb\xde\xf3\xba\x28\x26\x3d\x93\x36\xaa\x4e\x4d\x4b\x1d\xe9\xf8\xfb\x64\x18\x2c\x3e\xd8\x76\x96\xce\xe8\xe2\x52\xca\xa2\x6d\xd7\x4b\xf8\x31\xcb\x40\xcb\x92\x80\xe5\x92\x8e\xbf\x91\x70\xb3\x41\xb3\xa1\x32\x7b\xc3\xb5\x8f\x0b\x37\x4c\xdb\x7b\xb0\xac\xb6\x98\xf6\x5d\x0c\x8d\xa4\xb2\x7d\xe9\x72\xdd\xf9\xcb\x8e\x51\x97\xb6\xb8\x40\xb3\x58\x8c\xf6\xa4\x96\x19\x5c\xcf\x6c\x15\x78\x8e\x37\xc7\x90\xac\x77\x1d\x56\xdf\xcd\x0c\x72\x7b\x2c\x9a\xa4\xaf\xd8\x6d\x8f\xb0\x43\xbd\x82\x1b\x04\x7e\x25\xa4\x42\x87\xc1\xfa\xe7\x0a\xb8\x81\x1b\x5e\x14\xf0\x6b\xad\x0d\x5c\x22\x50\x9f\x20\x5c\x8f\xef\x8a\xe4\xde\x52\xde\x39\xfe\xd3\x4e\x62\x4e\xc0\x7b\x76\x13\xfe\x9a\x75\xa4\xb9\x26\xa1\x33\x7b\x06\x46\xd5\xb8\xd7\xda\x6c\xdb\xd1\x24\xd3\x7b\x83\x26\x71\xb6\x9e\xe9\x46\x56\x90\xb3\x42\xe3\xa4\x29\x71\x01\x7b\xca\x28\xd9\x4b\x1f\x93\xa2\x7b\xa6\xd1\x3e\xe8\xc7\x63\x9d\xf9\xb8\x73\x98\x88\xbe\x38\x40\xce\xa9\xf3\xce\x20\xc9\x73\xf8\xc6\x0b\x3a\xea\x66\x05\x2f\xfa\x3b\xab\xe3\xd6\x8a\xa5\x29\x56\x46\xbb\xc0\x6a\x5b\x29\x92\xdc\x35\x5c\xc9\x34\xec\x4e\x34\xf4\xa0\x19\xe1\xb1\x00\x0f\x69\x6c\x6a\xdd\x99\xac\xe6\xdc\xec\xd6\xeb\x96\x9f\x2f\xde\x9e\x43\x2a\x95\xc2\xd4\x14\x3b\xd0\xa8\x38\x2b\xf8\x3f\xa9\x3b\x9d\x8b\x4e\x46\x02\xad\xe8\x61\x8a\x59\x98\x23\xd6\xf3\xd7\x2f\xee\x35\x88\xdc\xea\xc2\x5e\x18\x84\xf4\x37\xb4\xf0\x85\xf7\xcb\x11\x7c\xaa\x5d\x13\xcf\x33\x12\x53\x9b\x8d\x95\xe2\xef\x73\x3c\xe3\xf9\xcb\x9c\x09\xe0\x0c\xef\x82\x9c\x2b\x59\x4e\x40\xcf\x06\x87\x83\x1d\xa2\xcb\x99\xbb\x9d\x51\x10\x08\x16\x5b\xa6\xa0\x19\x87\x11\x77\x6e\x9f\x9f\x39\xbc\x03\xb7\xe8\x72\x05\xdf\x35\xd3\xdb\x9d\x99\xcb\x1d\x77\xea\x85\x3b\xe6\x4d\x3c\xc9\xc9\x87\xee\x70\xe8\x19\x4c\x64\x5f\x90\xa9\xc8\x74\x2e\x59\x11\xb8\xe3\xf9\xdb\x93\xc2\x85\x51\xf7\xcc\x0b\x64\xc9\xc7\x4d\x0d\x0f\x75\xc0\xad\xa4\xbf\xf3\x19\xff\x1d\x0f\xb6\x85\xf7\xff\x78\xb6\x2d\xf0\xff\x95\xe3\x7d\x70\xba\xf7\x3d\xc4\xfe\x49\x7e\x78\xc6\x1c\x9e\xe5\x67\xde\x5d\xc8\x70\x6d\xeb\xab\xde\xd1\xb3\x5e\x2e\x55\x8a\xf6\x91\x0a\xa8\xe6\xed\x3d\xd0\x3d\x15\x75\xdd\xcc\xc5\xb0\x7d\xc5\xb1\xb5\x8b\x60\xe5\xc0\xc9\x3f\x0a\xce\x91\x3a\x5c\x64\x30\x7b\x1b\x2a\x73\xa8\xa4\xd6\xfc\xb2\xe8\xaf\x30\xfb\xeb\x53\x99\xcf\x3f\x0f\xce\x30\x8d\x62\xf8\xf8\x69\x5f\xc2\x9b\xb2\x22\x4b\x94\xec\x1a\xa3\xfe\xfb\x0a\x0a\x9c\x7f\x82\x8a\xa9\xf7\x97\xd5\x2e\xb2\xd7\xf0\xb3\x14\x83\x35\x4c\x59\xed\xad\xf1\x74\x6c\x84\x7f\x07\x00\x00\xff\xff\x49\x18\xa5\x7f\x01\x22\x00\x00")
+var _enumTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xff\x93\xdb\x36\x92\x28\xfe\xb3\xf4\x57\x60\xf5\x89\x1d\xd2\x91\x39\xce\x5e\x3e\xa9\x57\xde\x9b\xab\x72\x6c\x27\xf1\xad\xbf\xad\x67\x92\xdd\x7b\xb3\x73\x36\x44\x42\x1a\x66\x28\x42\x26\x20\x8d\x14\x45\xff\xfb\xab\x6e\x34\x40\x90\x04\x25\x79\x62\x27\x79\xef\x76\xab\xd6\x19\x91\x40\xa3\xd1\x68\xf4\x37\x34\x9a\xdb\xed\x7d\x96\x89\x69\x5e\x0a\x36\xba\x12\x3c\x13\xd5\x68\xb7\x1b\x9e\x9c\xb0\xc7\x32\x13\x6c\x26\x4a\x51\x71\x2d\x32\x36\xd9\xb0\x99\xbc\x2f\xca\xe5\x9c\x3d\x79\xc5\x5e\xbe\x3a\x67\x4f\x9f\x3c\x3b\x4f\xa0\xe5\x8f\xa2\x52\xb9\x2c\x1f\xb2\xed\x96\x25\x2b\xf3\x83\x19\x20\x6f\xc4\x2a\xaf\xdf\x55\xf4\x8b\x5e\x7e\xb3\xcc\x8b\x8c\x3d\xe1\x5a\x98\xd7\x13\xf8\x0d\x3f\xbd\xf7\x9a\x7d\xb3\xa9\xdf\xea\x6f\x36\xf0\x6e\xb8\xe0\xe9\x35\x9f\x09\xb6\xdd\x26\xf4\x27\x3c\xcd\xe7\x0b\x59\x69\x16\x0d\x19\x63\x6c\x34\xd9\x68\xa1\x46\xe6\xef\x8c\x6b\x3e\xe1\x4a\x9c\xa8\xf7\xc5\x49\x56\xe5\x2b\x51\xd1\x1b\x51\xa6\x32\xcb\xcb\xd9\xc9\x24\x2f\x79\xb5\x69\x3f\xfd\x49\xc9\xb2\xfd\x6c\x3d\x2f\xec\xa3\xaa\x92\x95\x1d\x63\x3a\xd7\xf4\x57\x2e\xed\x1f\xda\x8d\x33\xe7\xfa\xea\xa4\xe2\x65\x46\xbf\x4b\xa1\x4f\x96\x95\x05\x54\x89\x69\x21\x52\xdb\x5f\xc9\xca\xfd\xa9\xab\x54\x96\xab\xfa\x57\x5e\xce\xec\x80\x6a\x53\xa6\xa3\x21\xfe\x0d\x8b\x98\x4f\x59\x32\x51\x86\xf2\xf0\x6c\x34\x93\xc9\x5c\x96\x33\x99\x4d\x12\x59\xcd\x4e\xf0\xef\xfb\x66\xf2\x27\x93\x7a\x5e\x87\x9a\x61\x5b\xbd\x59\x88\x91\x1b\x4a\x94\x99\x1d\xc5\x8e\xbc\x98\xad\xeb\x81\x73\x7d\xb5\x9c\x24\xa9\x9c\x9f\xfc\xc4\xd3\xeb\xf4\x64\x31\x5b\x9f\xac\xfe\xff\x93\xc5\x2c\x08\x26\x1e\x6e\xb7\xf0\xe7\x7d\x58\x43\x9f\x1d\x81\xd9\x46\xbb\x1d\x3e\xab\x78\x39\x13\x2c\x81\x47\xc9\x13\x99\xc2\x58\xdb\x2d\x8e\xcc\x76\xbb\x93\x13\xe0\x84\xdd\x6e\xbb\x65\xa2\x50\x02\x9f\xc0\xdf\x06\x4d\x6f\xa8\x54\x96\x0a\x18\x04\x1e\x7d\x06\xb0\x5e\xf2\xb9\x60\x0f\x4f\x09\x30\xfe\xba\x4f\x5d\x3e\x5b\xf1\x62\x29\x5e\xf0\x05\xbc\x5f\x54\x79\xa9\xa7\x6c\xf4\xf6\x8e\xfa\x11\x1e\x8f\x42\x3d\x00\x9b\x82\xff\xbc\xa9\x04\x6c\x02\x31\xe7\x0b\x86\x38\xd5\x90\xba\x80\x5e\xf0\x45\x14\x37\xa0\x61\x17\x4b\x0f\x87\xe8\xf9\x66\xe1\x21\x8a\xbf\xdc\xfb\x15\xaf\x14\xbc\xcb\xf2\x54\xb3\x51\xc1\x95\x96\xd3\xa9\x12\x7a\xc4\x46\x0f\x46\x04\x86\x08\xf8\x59\xf5\xac\xcc\xc4\x7a\x4c\xb3\xab\x21\xe2\xac\x14\x90\x6b\x80\x30\x01\xca\x2b\x84\x02\x6d\x16\xc5\x32\xbd\x6e\x82\x36\xa3\xfe\xc2\xa6\x79\xa5\x34\xcd\x53\xba\x0e\xf4\x17\x0d\xe7\x4d\x81\xc6\x35\xe3\xc0\xfa\x89\xf7\x84\x8b\xa1\xe5\xe8\xed\x08\x56\x8f\x9d\x5d\xe7\x8b\x85\xc8\x98\x79\xb5\xdd\xc2\xba\xd2\x42\x53\xf3\xd7\x95\x98\xe6\x6b\x91\x41\xb7\xdd\x8e\xe5\x8a\x71\x78\x69\x57\x75\xb7\x63\x72\xca\x80\xe1\xea\x2e\xe6\x79\x82\xec\x66\x67\x9a\x4f\xed\xf8\x8f\xe5\x7c\x2e\x4a\x0d\x2f\xfc\x71\xbc\xc7\xc4\x49\x8e\xf5\x01\xff\xcf\x92\x49\xae\xa7\x05\x9f\x21\x0d\xc2\xb8\x35\xd1\x3a\xad\x61\x23\xd5\x7d\xbe\xed\x87\x60\x69\x45\x14\x7d\x60\x86\x6b\x80\xcd\xa5\xe6\xa6\x21\xec\x9e\x07\x23\xb7\x20\xbb\x1d\xfb\x82\x79\x0b\x04\x5d\x71\x1e\x86\xae\xd4\xc3\x5f\x73\xbf\x65\x77\x90\x5e\x68\x9f\xbd\x85\xc5\x87\x87\x86\x3d\x9a\x1c\x63\x60\x3a\xfe\x26\xf6\xc5\xae\xc3\x18\xb6\x3e\xd3\x62\xbe\x28\x40\x01\x90\xa8\x13\xd5\x08\x37\xf8\x70\xb8\xe2\x15\x7b\xbb\xdd\xd6\xfb\x64\xb7\x33\x1b\x6a\xbb\x65\x73\xbe\xc8\xa7\x1b\xb3\x35\xb0\x31\xf0\x0f\xf6\x67\xf9\x7c\x51\x08\x58\x55\xc5\xf4\x95\xa0\xa7\xa2\x62\x79\xa9\x45\x35\xe5\xa9\x48\xdc\xce\xad\x97\x11\x14\xd7\x23\x96\xca\x39\xe8\x04\x0d\xfa\x4a\x4e\x19\x2c\xb1\x02\x2e\xbb\xa9\x72\xad\x45\xc9\x38\x82\xcc\x2b\x56\xf2\xb9\x50\xec\x27\x99\x97\x22\x63\x37\xb9\xbe\x62\xbf\x24\xbe\xd0\x99\x2e\xcb\x94\x45\x6b\xd6\xc4\x3e\x26\x64\xa2\x98\x99\xb9\xb2\xed\x70\x90\x4f\xe1\xc7\x98\xc9\x6b\xa0\x63\x77\xbe\x17\xeb\xcb\xbf\xc0\xcb\xed\x70\x30\xa8\x84\x5e\x56\x25\xb4\x1f\x0e\x6a\x5e\xf6\xb8\x71\x38\x00\xa2\x19\xec\x2e\x2e\xcd\x20\xc3\x41\x25\x94\x06\xe0\xeb\xe1\x60\x2a\x2b\xf6\x76\x8c\x33\x83\x27\x46\x42\xb4\x06\xfd\x16\xa7\x0d\xe3\xe5\x53\x06\x7d\xef\x62\xf3\xd3\x53\xd3\x0d\x5e\x0c\xcc\x10\xa7\x8c\x2f\x16\xa2\xcc\x22\xfc\x39\x0e\x61\x0f\x5d\x2e\x63\xe8\x02\x90\xd8\xdd\xff\x36\x50\x86\x03\x98\xc0\x0e\xa7\x5f\x88\xd2\x00\x88\xd9\x7f\xb0\x07\xec\xee\x5d\x1c\x94\x9d\x9e\xb2\x07\xad\x59\x83\x26\x4c\xfe\x53\xe6\xd4\x7e\xcc\x46\xbf\x8c\x62\x47\x0a\xa2\xbd\x6d\x3f\x9d\xeb\xe4\xcc\xc8\xde\x68\xd4\x44\x2c\xba\x93\xc5\xa3\x31\x5b\xc7\x43\x54\x3f\x0d\x22\x82\xec\x3c\x39\x09\xd3\xe4\x4a\x16\x19\xb2\x00\x53\x79\x39\x2b\x04\x9b\xe4\xda\x88\x2b\x05\x92\xa7\xd9\x65\xcc\xf2\x92\x65\x22\x2d\x78\x45\x1c\x55\x65\xa2\x4a\x42\x6c\x6d\xa0\x9f\xb2\x8b\xcb\xe6\xf3\xad\xa7\x07\x01\xb9\x06\xcb\x0f\xb6\xdb\x96\xc8\x18\xfb\x2c\x68\xf6\xc4\xf7\x5c\xb1\x4a\x80\x8d\xa4\xd8\xcd\x95\xd0\x57\xa2\x62\xbc\x28\x70\x0e\x93\x5c\x2b\xcb\xe6\x8c\x57\x02\x37\x71\x5e\xb2\x75\xd2\xcb\xbf\xdf\x73\x15\x01\x22\x9d\x17\x13\x29\x0b\xb6\x75\xb4\x5f\x37\x58\x86\x70\x39\x13\x9a\x99\xf7\x8a\xad\xcd\xae\xe9\xa0\xa1\x84\xee\x1f\xfd\x4c\xe8\xf0\xe8\xcd\xdf\x3e\x1e\xec\x17\x1f\x83\xc7\x85\xe0\xd5\x41\x1c\x52\x68\x25\xb2\x7e\x3c\x10\xcc\x07\x63\x72\xf7\xbf\x7d\x54\x9a\x0d\xcf\x84\x7e\x35\x75\x88\x01\x46\x2d\x40\x1d\x4c\xed\x22\xce\xf2\x95\x28\x49\x52\xd5\xc4\x0b\x40\x47\x7c\x15\x4b\x92\xe4\x30\xce\xc0\xa2\xed\x69\xf7\xc8\x8e\xa9\x13\x16\x6b\xf6\x8b\xdd\xdb\xf5\x26\x5c\xd3\x74\x9f\xf2\xf4\x8a\xa5\xbc\x28\x14\x9b\x96\x86\xee\x60\x31\x6d\x3a\x1b\xc9\x31\x61\xdf\xf6\xe9\x5b\x14\x18\x21\x9a\x96\x0c\xde\x47\xad\x97\x31\xdb\xf6\xa0\xbf\x4f\xf4\x35\x99\x18\x9f\x0e\xa6\x25\xd2\x31\xb6\xf2\xab\x61\x6a\x5a\x51\xb2\xe2\x45\x9e\x81\x4a\x23\x59\xf2\x0c\xec\xbe\x3c\x63\x8b\x4a\xae\xf2\x4c\x80\xd5\xf2\x7e\x99\xa7\xd7\xec\x86\x6f\x98\x96\x2c\x13\x5a\x54\x73\x70\xc7\xf2\x29\x2e\xb2\xde\x38\x3b\x08\xd4\xcf\x82\x57\x1a\xb8\x13\x5e\xf1\xa2\x90\x37\x22\x63\x80\x34\xb9\x69\xd8\x4e\xf5\x53\x86\x86\x8f\xea\x5d\x0a\x38\xe3\x1a\x20\xa6\x4d\xa9\x42\x0b\x07\xee\x97\x33\x0d\xc9\x52\x19\x0e\xde\xee\xd5\x53\xae\xb3\xbc\x6e\x48\xe4\x20\x91\xc0\xe3\x11\xd9\x82\x57\xca\xd0\x29\x20\x16\xcf\xb0\x89\x51\xf8\xd0\xbc\x46\x34\x99\xca\x2a\x15\x40\x89\x8a\x25\xf8\x9f\x94\x1b\x14\x03\xb2\xfb\xb9\x94\xd7\xcb\x05\x03\xcd\x5e\x6d\x98\x12\xbc\x4a\xaf\x04\x89\x71\x33\x02\x6a\x13\x06\x0c\xc2\x4b\x26\xd6\x3c\xd5\x6c\xce\x75\x7a\x45\x34\x0d\xc2\x43\x15\x44\x4a\x29\x66\x2d\x8e\x1b\x23\xa9\x91\xed\x72\x20\x17\x60\x9f\x9c\xe1\xc8\x11\xa8\xbb\x16\x44\x33\xd1\x78\x6c\x98\x37\x07\x53\xc5\x2e\x16\x09\x85\x30\x69\x2e\xf2\xcb\x04\xd1\xf8\x8f\x53\x34\x49\xd8\x2e\x46\x8d\x9a\xb3\x7f\x67\xfd\xc3\x80\x86\xdd\x0f\xee\x94\xc0\x79\xda\xb7\xb7\x03\x72\xdf\x98\xe9\x6a\x29\xfc\x8d\xdf\x6c\x1e\x3d\x80\xc9\xf1\x42\x09\xcb\x0c\x64\x83\xb6\x9d\x27\xcb\x09\xd1\x70\xd0\x1a\x11\xed\x66\x70\x23\xc1\xf6\xbb\x30\x74\x6f\xa9\xcb\x70\x9f\x57\x65\x2a\x18\x38\xce\x09\xfc\x35\x8c\x43\x2c\x82\x61\x09\xeb\x9c\x31\x08\x3b\x90\x9e\x47\x32\x68\x49\x7b\x11\x30\x5c\x2a\x13\x19\x01\xce\xcd\xcb\x59\x98\x45\x1a\xf0\xa2\xb8\x1f\x65\x4f\x43\x6c\xb7\x6c\x59\x36\xec\xda\x26\x67\x07\x79\xdb\xe1\xec\xeb\x8e\x83\x48\x8f\xcd\x14\xd1\x5a\xd6\x4c\x96\xe4\xd1\x2d\x95\x08\x4f\xe7\xd8\x99\x84\xba\x01\xd1\x93\x27\x32\x02\xb8\x11\xee\x88\x60\x33\x76\x7a\x80\x86\xc3\xc1\x2e\x76\xb4\x0a\x41\xf0\x39\xab\x47\xa0\xd8\x91\x0e\x91\x9a\xc4\x15\x89\x93\xd7\x20\xa3\x9a\x80\x18\xd7\xe0\xb7\x68\x05\x64\x86\x68\x8d\xa8\x34\xe3\x24\x0d\xe0\x19\x6f\x6d\x00\xa2\x6b\x00\xd4\x01\x39\x82\xf1\xa6\xd8\x0a\x6d\xd8\x31\x30\xee\x86\x1b\xbf\x1d\xbc\x38\xda\xb0\xa3\x91\x6f\x2c\xc3\xe8\xa6\xdd\x6e\x37\x66\x65\x5e\xf8\x56\x32\xf5\x5c\x5b\x61\x1e\x90\xc8\xbb\x5d\xbf\xd0\x8b\x7d\xdf\x95\x3c\x69\x70\xcc\x76\xbb\x0b\x78\x7d\xe9\x7c\x3d\xe7\xb7\x58\xd4\x33\xb1\xa8\x44\x8a\xd6\xf0\x95\x94\xd7\x38\x85\x36\x37\x3c\xbe\x12\xe9\xf5\x13\x6a\x28\xb2\x68\x1d\x0f\x07\xbe\x32\x71\x53\x5c\xdb\x79\x6d\xb7\x00\xbb\x94\x76\xf5\x06\x10\xc9\x84\xbf\xf3\x52\x89\x52\xe5\x3a\x5f\x09\xe4\x7c\x31\x66\x19\x2c\x8d\x12\x0b\xb0\xc9\x05\x2b\x70\x52\xb0\x5e\x0b\x08\xe0\x94\x9a\x2d\xcb\x52\xa4\x42\x29\x5e\x6d\x58\x2a\x15\xaa\x5d\xcb\x1a\xb0\xb4\x6e\x8d\xf3\x29\xbb\x11\x2c\x93\xe5\xe7\x9a\x95\x42\x64\x4c\xcb\xe4\xd6\x54\xb5\xae\xcd\xb9\x7c\x0e\x63\x21\x4b\xc4\x7b\xc8\x1c\x6c\xff\x3b\xd0\xdd\x71\x53\xc8\x13\x35\x8e\x2d\xba\x6c\x8f\x65\xa9\x79\x5e\x2a\x9c\x98\xf1\xda\x10\xbf\xb0\x9d\x69\x2d\x35\x34\x7b\x9c\xa5\x66\x61\x9d\x2d\x8a\x5c\xb7\x01\x0d\xc0\x28\x1b\x33\x51\x55\x40\xf9\xd0\x2e\xb3\xdd\xcf\xab\x7c\x7e\xb6\xe0\xa9\x88\x00\x7c\x0c\x93\x84\x55\x83\x9e\x7f\x3a\x85\x89\x21\x62\x6e\xb2\x2d\x28\xa0\xc6\x44\x55\x41\x0b\x20\xa1\x6f\xf3\x0e\x42\x24\x6a\x98\x41\x83\x46\xb4\x03\x37\x76\x5e\x2e\x96\x9a\xe2\x1d\x03\xe4\x63\x59\x31\xd4\xc1\x0a\xc3\xea\x13\xa9\x04\x36\x54\x68\x23\xed\xc1\x0a\xbc\xdd\xa7\xd0\x72\x1a\x8d\xee\xbc\x07\xab\xb1\x94\x20\x92\xd0\xc2\x6b\x75\x00\x6d\xbd\x61\x17\x77\xd4\xe5\x68\x8c\xf2\x63\xec\x56\x0a\x9d\xeb\x16\x67\xbc\x24\x5f\x7b\xcc\x46\x40\xaf\x86\x90\xfd\x48\x18\x11\x1e\x16\x3a\x8a\xa8\xda\x54\x0c\xb0\x6f\xc7\x83\xb2\x9c\x9b\xcb\xf2\x7b\x29\xaf\xc7\xe0\xed\x96\xe0\x4c\x8c\x81\x16\xe0\x79\x18\x0d\x18\xe0\x0d\xdf\x1d\xb1\x43\x89\x36\x86\x2c\xd7\x46\x86\x28\xe3\xc1\xef\x1d\x3d\xe8\x84\x04\x03\x5a\xb6\xa3\xc8\xd8\x29\xea\xd6\xe6\xeb\x4b\x30\x02\xfd\x28\x40\x20\x98\xeb\x51\x47\x91\x36\x83\x85\xe9\x09\x2b\x3e\x44\x4b\x6d\x4c\xe1\xbb\xb0\x51\xd1\x12\x05\xe4\xb7\x81\x65\xe1\x8d\xc5\x50\x92\x80\xb1\xa9\x81\xc2\xe0\xb6\xf1\x32\x63\x6b\xf8\x61\x9b\x39\x27\x7a\xff\x00\x01\x9f\x05\x0c\xe7\x76\x40\xa5\x4d\x64\xda\xaf\x5d\x6b\xb6\x86\x7c\xb1\xbe\x24\x41\xb8\x07\x10\x8a\x3a\xb0\xaf\x2c\x51\x2c\xdf\x55\xfc\xc6\xca\xed\x1e\x3b\xe0\x5c\x5e\x8b\xd2\x1a\x00\x8a\xf1\x92\xf1\xa2\x12\x3c\x03\xb7\xee\x5a\x94\xf9\xcf\x22\xdb\x63\x14\x8c\x8d\xaf\x51\x6c\x58\x91\x5f\x8b\x10\xfc\x7e\xb3\x01\x47\x8e\xb4\xbc\x3e\xc6\x74\xa0\x4d\x1a\x00\x03\x10\x62\xe2\x82\xc0\xeb\x37\xfc\x06\x95\xa4\xb1\x2b\x71\x4e\x6c\xb9\x80\x38\x68\xae\xc6\xb8\x6f\xe4\x12\xd6\x7d\xc3\x4a\x59\xcd\x79\x91\xff\x8c\x54\x1d\x23\x2b\xb4\xe3\x4e\x86\x51\xc2\x02\xa0\x7f\xa2\x6f\xf8\xcd\xfe\x69\x3a\x4f\xcb\x2a\xa1\xa6\xc6\xed\xb3\x19\x49\xf5\xe2\xfc\x9b\x4e\xae\xaf\xc1\x1b\x6a\x57\xcb\xeb\x4b\x07\x0e\x5b\x35\xe5\x55\x9b\x7f\xe6\x4b\xa5\x7d\x06\x7a\xb1\x54\x3a\x30\x43\x8f\x7f\xf6\x32\x0b\xd0\x74\xc1\xcb\x3c\x55\xa0\xd4\x49\x9e\x22\x31\x89\x7a\x3d\xf0\x9b\x16\x66\xf3\x1d\x70\xc7\x8a\x17\x7b\x55\x27\x49\xe6\xae\x96\x44\x64\x22\x51\x55\xb1\xef\xf9\xad\x78\x11\xa0\x05\xd2\x41\x56\x99\x98\xf2\x65\xa1\xfb\x77\xd4\xab\xea\x09\x35\xf9\x00\xaa\x58\xe7\x27\x13\xd3\x5a\x22\xb5\xa9\xb3\x6f\x30\x9f\x44\x63\x38\x1b\x67\x87\x83\x64\xf9\x94\x1d\x45\xb9\xbf\x20\xd9\x4e\x6b\xb2\x79\x74\xf2\xc8\x96\x89\x69\x88\x85\x78\x75\x2d\x2a\x22\xd7\xf9\x95\x60\x0a\x10\x9d\x0b\x7d\x25\x33\xd0\x6e\xb0\x15\x67\x12\x31\x7b\x5a\x2e\xe7\xa0\xf6\xf2\xf4\x0a\xa6\x9f\x82\x8c\xa5\xd3\x09\xd8\xa4\xe6\xa4\x9c\x5c\x3e\x25\x71\x43\xd7\x47\xfd\x29\x9c\xfc\xcb\xb2\xd8\xa0\x31\x4b\xc1\x11\xcd\xcb\x8c\x57\x19\x2b\xf2\x49\xc5\xab\x0d\x85\xae\xeb\x83\x14\x98\x4d\xeb\x44\x63\x38\xf8\x4e\x02\x22\x51\x3c\x84\x93\xa3\x16\x3d\x1e\x18\xe7\xdb\x34\x61\x73\x5e\x5d\xab\x36\x61\x39\xec\x82\x1a\x2f\x78\x35\xae\x8f\x74\x00\x7d\x6f\xba\xb4\xb2\x2d\x91\x10\xd3\x00\xe0\x6d\x06\x18\x51\x57\xfb\x4e\x68\x5e\xeb\x2a\x8a\xd9\xbd\x5e\x2f\xfd\xee\x3a\xb0\x4a\xb2\xca\xf2\x92\x17\x78\xd4\xac\xac\x03\xf9\x19\x3d\x05\x8b\xf4\x41\xfb\x24\xfa\xd8\xa3\x59\x77\xb6\xd7\x3a\x30\xb5\x7e\x4e\x8f\x96\x7f\x45\x43\xe7\x56\x6d\xb7\xc2\xa8\x2c\xc7\x13\x45\x39\xed\x03\x90\x0c\x07\x07\x40\xc3\xe2\xda\x29\x5a\x17\xc0\x4d\xf9\x94\xf1\x2c\xab\x7f\x7e\xd9\x38\x7e\xa4\xc3\xbf\x1e\x22\xb2\xb0\xeb\x4e\xc3\x1e\x3a\x25\xf9\x95\x14\xed\x99\xb3\x35\x97\x2c\xca\xbb\xe1\x1e\x14\xdd\x19\x25\x4d\xa8\x0e\x32\x50\x3c\xa1\xd9\x0b\x63\x12\xe7\x92\x3a\x37\xce\x00\xf6\x2c\x5b\xf7\x88\xc0\xe8\xdc\xb6\xae\x35\x79\x15\x14\x23\xa6\xed\xb2\x6f\xfc\x68\xd5\xd9\x11\x51\x5e\x6a\x3f\x9e\x69\xb5\x63\xef\xec\x2f\x56\xb5\x96\xc4\xd6\x64\x5f\x04\xdb\x9f\x4b\x44\xa0\x31\xef\x66\x43\xc6\xb5\x77\xd2\xd1\x43\x93\xe6\xec\xa1\x39\x3e\x06\x22\xe4\xa5\xf1\x20\x83\xb3\x6f\x62\x61\x43\xaf\xfd\x36\x06\x05\x57\x1f\xb0\x5f\x7e\x61\x39\xfb\x8f\xd3\x50\x98\x95\x60\xaa\xb8\x1d\x90\x09\xc6\x43\x3d\x15\xd0\x03\xe7\x22\xbf\xa4\xf8\x6a\x88\x8e\x67\x5a\x2c\xd4\x37\x42\xdf\x08\x51\x3a\x2a\x5e\xc9\x1b\x36\x07\xb3\xac\x4b\x2e\x05\xed\xd9\x04\x28\xc3\xa7\x1a\x8e\x03\x3d\xa5\x51\x8a\x19\xc7\x70\x09\x7a\x4f\x13\x38\x10\x17\xca\x44\x07\x13\x18\xfa\x51\x09\xca\x4c\x56\xd0\xdb\x8c\x25\x32\xd8\x4e\x22\xc7\x93\x45\xc3\x98\x73\xab\x7d\x6b\xf6\x6b\xa2\x1c\x5c\x09\x7f\x1e\x11\x1f\xb3\x49\x0f\x23\xd6\x56\xed\xb4\x92\xf3\xc3\xcc\xc8\x2f\x71\xd5\xfe\x24\xaf\xfd\xe5\x78\xd0\xf2\x4f\x57\x87\x70\x1e\x8d\x19\x37\x66\x8e\x96\x87\x07\x9d\x7c\xb4\x41\x27\x0d\xdb\x4a\x4b\x76\x9f\x99\x79\x43\xec\xab\xab\x89\x20\x03\x0e\x74\x79\xda\x23\x46\xbf\xd9\x68\x41\xa2\xf0\x8f\x2b\x48\x01\xc9\x83\x52\x14\x1a\x39\x7e\xf7\x8f\xe4\xe1\xb9\x4d\xdc\xeb\x13\x95\x8e\xe1\xe1\x34\xb5\x47\xa4\x20\xc3\x3f\xd3\x9e\xc9\x1d\x90\x4d\x9d\x05\xa4\xe3\x90\xa5\x12\xec\x5c\x22\x8e\x5a\xb2\x19\x18\x6a\x6e\xeb\x94\x4a\x0b\x9e\x25\xbd\x26\x08\xf4\x82\xc3\x3a\xe8\xbc\x1d\x0e\x26\xce\xc4\x5c\x27\x06\x64\xb4\xc7\x1a\x6f\x64\x3d\xdc\x59\xb5\x31\xb9\xe2\x65\x56\x08\xb6\x2c\x1b\xf8\xaa\x11\x8e\x11\x37\x78\x6d\x42\x62\xfb\x5c\x7e\x72\x52\x8f\x81\xd4\x74\xfa\x06\xdb\xfb\x03\x89\x6d\xd2\x72\x2a\x61\x1a\x18\xc2\x69\x69\x30\x13\x10\x9f\x00\x47\xb5\x9f\xde\x96\xa8\x2c\x82\x9e\xbe\x84\xe9\xd3\x75\xc4\xa0\x17\xeb\x8f\xb3\xcd\x51\x77\x5d\xf1\x1a\x79\x4b\x53\x93\xa3\xe2\xaf\x09\xb0\x46\x1e\xdb\xdd\xdf\xd5\x06\xdf\x56\x72\xde\x59\xae\xd6\x68\x08\xdd\x44\xc3\xda\x8b\x39\x19\x83\x43\xb1\xa8\x64\xb6\x4c\x4d\x8b\x66\xdf\x04\x60\x13\x25\xc3\x03\x47\x13\x84\x14\xd0\xa1\x1e\x59\xa7\xa0\x66\xa3\x49\xdc\xa3\x40\x6b\x21\x75\x50\x85\xfa\x74\xce\x6a\x3a\xa3\xdf\xd7\xe5\xcf\x03\xd2\xb5\x17\x8d\x8b\xc9\x65\xaf\xc0\x35\xa7\xca\xd6\xe6\xc7\x44\x92\x87\xa7\x74\xd8\x8c\xbf\xbc\xf4\x4d\x0a\x03\xf0\x4a\x5d\xf1\xe2\x1b\x6c\xd2\x4e\x57\xa3\x53\xea\xb9\x69\x53\x88\x8a\x9c\xbd\x71\x3d\x91\xc0\x92\x42\x40\x46\x83\x2f\xc5\xb2\x7c\x45\x58\xfc\x2f\xb6\xdb\x19\x12\x14\xb9\xd6\x85\xb8\x2f\xca\x2c\xe7\x65\xc3\x14\x0c\xec\x86\x06\x76\xb0\x29\x2e\x2e\xdb\xdb\x82\x02\x2d\xe2\xbd\x37\x92\x23\xa2\x69\xbe\x85\x7f\xa2\x75\x6c\x0f\x9f\x1a\x01\x16\x48\xcd\x06\xfd\x39\xe7\xd7\xc2\x81\xef\xe0\x1e\x0f\x07\x86\x18\xc9\x73\xc4\xff\x29\xa2\x9f\xbc\x5e\xea\x1f\xf2\x52\x6f\xb7\x38\xcb\xdd\x2e\x02\x68\x63\xb6\x6c\x3c\x5b\xc7\xb1\x43\xc8\xbc\xaf\xb1\xf0\xd3\xa3\x7e\x28\xe7\x47\x2c\xc6\xb2\xec\x2c\xc7\x5e\x6b\x08\x46\x64\x99\x14\x86\x1b\x21\x69\xac\x77\xeb\xd7\xeb\xd0\x72\x39\xe3\x36\x6e\x38\x4f\x66\xa8\x15\xd3\xb8\x60\x8e\x4f\xd1\x04\x85\x97\x31\x68\x83\x2e\x0b\x78\x3b\xc8\x17\x4b\x29\x2f\x01\x3b\x37\x37\x76\x07\xf6\xbb\x16\x60\x30\x6b\xd9\x42\x13\x22\x95\x0b\x91\x82\x47\xde\x19\x60\x34\xae\x31\xa8\x93\xf1\x3e\xab\xf8\x0d\xac\xf1\x08\x30\xbb\x78\x70\x39\x6a\x58\x0c\xae\x33\x1c\x20\x42\xcb\x3a\x4b\x3a\xb4\xe6\xb0\xe0\x77\x32\x33\xc4\x88\x7a\xfb\x39\xa5\x96\x23\x97\xa5\xca\x67\xb0\x08\xcd\x3d\x37\xd0\x73\xcc\xe8\x6e\xce\x29\xda\x6e\x01\xc9\xdd\xae\x1d\xff\x0b\xb7\x6e\xf0\x97\xeb\x1a\x37\x4f\x78\xf2\x29\xdb\x97\x22\xa3\xe7\x8b\xcb\xbf\xb4\xd5\xc5\x7e\x19\xd6\x04\x32\x1a\x33\x3d\x5f\x18\x2a\xdf\x5b\xb3\x53\xf8\xe5\x18\x3d\x2c\xa0\x74\xc5\xf1\x0c\x54\x96\xaa\xc7\x26\x3c\xf7\x5a\x20\x03\xd9\x2e\x6d\x93\xeb\x91\xc9\x3d\x7a\x29\xd6\x75\x22\x1f\x08\x23\xca\x80\x0c\xc8\xa5\x94\x97\xac\x46\x80\x81\xe9\x9c\x97\xb8\xb5\x8c\xf6\xd7\x57\x62\x83\xa9\x87\xc6\x30\x80\xf3\x02\x8a\x73\xb9\xfd\xa4\x8a\x3c\x45\xcd\xce\x59\x2a\x17\x1b\xe3\xe8\xe5\x8a\xe1\x31\x38\xe6\xec\x98\xe4\x29\x5e\x10\x1e\xfd\xa2\xcd\xc3\x3f\x8a\x3b\xd6\x2f\xac\x49\x09\x53\x7b\x78\xba\x87\x42\x7e\x9a\x13\x25\xbf\x92\x18\x6b\x76\x19\x83\x19\x00\xfb\x02\x40\xc6\xa0\xb1\xc5\x5a\x27\x49\x12\x07\x96\xc8\xe6\x8b\x65\x37\x15\x80\xb4\xe1\x3e\xcc\xda\x6a\x42\xa5\x0c\xd4\x76\x14\x94\xe9\x2b\x8e\xc1\xf5\xeb\x52\xde\x94\x60\xe7\x4d\x44\xd7\xf9\x3f\x39\x61\x2f\xc5\x4d\x08\x2a\x85\x89\x30\xfe\x47\x39\x69\x98\x20\xc2\x64\x09\xe6\x15\x9c\x94\xa2\xd7\x81\xad\x7e\x16\x95\x0c\xe2\x66\x2c\x3d\x83\x61\xf3\x55\xf4\x20\x4e\x86\x90\xd4\x16\xec\xa7\x74\xb5\x4c\x35\x90\xbf\xbd\x64\x24\xa5\x7b\xb0\x06\x6a\x29\x38\x6f\x37\xbc\x02\xaa\xd1\x37\x20\xeb\x28\xf0\x01\xe1\x1b\x06\x1f\xe0\x9f\x28\xd0\xac\x65\xd7\xec\x4f\x90\xeb\xec\xfd\x00\xc0\xed\xee\x90\x59\xd3\x6c\xdf\xb1\x11\x43\x30\xd7\x0f\xd9\x7a\xd7\x6f\x35\x36\x76\x33\x90\x75\xd1\xab\xa8\x56\xa1\x25\x6c\x87\xc7\xa3\x50\xbc\x9c\xd0\x5b\x25\xeb\xe1\x87\xe6\xeb\xef\x1d\x3a\x90\x53\x5f\x0f\x95\xd8\xb7\xc3\x9d\x17\x52\x07\xad\x4a\x9b\x8c\xcc\x9d\x73\xd8\xf8\x2d\x4c\x34\x3c\x0b\x68\xfe\xbd\xd8\x78\xf0\xc2\xc6\x93\x87\x5b\xa3\x6d\xdb\x22\xe9\xc5\xa8\xcf\x1a\x79\x05\xbb\xd7\xa6\x7f\x29\x30\x77\x1b\xfb\x5f\xa1\x9c\xe5\x69\x2a\x16\xf5\xb9\x6c\xb4\x62\xf7\x82\xd3\x68\xa0\x11\xe1\xb8\x1d\xd3\x63\xbd\xf7\xec\xc3\x1c\xa6\x60\xd7\x38\xe8\xaf\x12\x21\x30\x9d\x62\x37\x1c\xdc\x5b\x19\x70\xa7\x3d\x42\x0a\x4f\x69\xbd\x3e\x2e\x4b\x8b\xed\x9a\x12\xb5\x4e\xb1\x20\xdf\x96\xae\x93\xd0\xf2\x23\x51\x6a\x7d\xd8\xd9\x0b\x4f\xdc\x7b\x96\x09\x95\x56\xf9\x44\xd0\xa1\xe5\x52\x84\x92\xfc\x45\x32\x4b\x50\x0d\x29\x51\xad\xac\x79\x0e\xf0\x58\x3d\x12\x48\x64\x0e\x12\xb4\xd4\x80\x30\x57\xec\x3f\xcf\x5e\xbd\x24\x91\xd8\x3b\x7c\x2d\x17\xe1\x15\xa3\xff\x11\x97\xbf\x83\x6b\x8a\x0f\x47\xb0\xd6\xa3\x77\xc3\x41\x9d\x1d\xc9\x1c\x86\x60\xfe\xec\x76\xb6\x25\x12\x03\x9a\x3e\xc1\x59\x2d\xec\x10\x1e\xb0\xac\x7e\x63\x1a\xda\x63\x74\x86\x01\x50\xc6\xea\x86\xf6\xcd\xe8\x5d\x4f\x0c\xa7\x9e\x47\x28\xd2\x54\xbf\x3d\x10\x73\x4a\x79\x29\xcb\x3c\xe5\x05\xf9\x51\xb0\x64\x83\x2d\x00\x79\xd8\x7b\x74\x61\xb7\xfc\x18\x44\xd5\x12\x2f\x9a\x7e\xe6\x53\x24\xea\xe9\x18\x8f\x99\x47\x1b\xe8\x66\x6d\xd2\x3b\xef\x47\x2c\xf2\xa8\x43\x08\xc6\xb0\xfe\x35\x95\x3c\x8c\xea\x87\xbb\x3a\xe8\x45\x3b\xbc\x9f\x4e\xbe\x14\xf6\xd9\x54\x4e\x7d\x33\xeb\xe8\x8b\x26\x64\x49\x15\xb9\x42\x4d\x08\x1a\x1d\x92\x3d\x53\x50\xe9\x65\x46\x09\x2f\x90\xdb\xe1\x06\x36\x26\x16\x70\x79\xae\x9d\x4d\xa1\xf8\x14\xc3\x29\x73\x99\xe5\xd3\x0d\x09\x8e\xde\x49\x04\x0c\xab\xfa\x2d\xdb\x3a\xeb\x3a\x68\x34\xd5\x2d\xc7\xa1\xb8\x40\xfd\x5a\x81\x48\x01\x5c\x23\x3d\x5f\x8c\xd9\x9e\x76\x4e\x66\x80\xa5\xdc\x35\xbd\x4a\xbe\xca\x21\xe4\x2d\xcb\x1e\xe3\xf8\x65\xdd\x60\x7f\xc0\x34\xcc\xa9\x9d\x2b\x3a\x1e\x2f\xec\x1d\x0d\x77\x42\x38\x27\x08\x3c\x91\xdb\xec\x9a\x30\xd7\x23\xd7\x1a\x10\x1d\x56\xed\x58\xfa\x4d\x4c\x88\xe9\x44\x46\x27\x09\xeb\x31\xeb\x90\x35\x2d\xf8\x1c\x92\x27\x24\x64\xfa\xe5\x5a\x89\xc2\x3b\xa2\x07\x46\x87\xeb\x82\x60\x67\xd6\xd9\x8e\x68\x80\x80\x58\xe2\x95\x5c\x96\x90\x59\x89\x63\xe3\x29\x04\xb4\xa4\xd1\xda\x9d\xd1\x05\x43\x2b\xf7\x87\x56\x74\x13\x35\x9f\x73\x28\x96\x65\x7a\x05\x84\x73\x3a\xb0\x6b\xe8\x91\x87\xd0\x9a\xed\x9e\x80\x60\x6b\xdd\x7a\x02\x83\x6b\xe7\x1d\x87\x69\x44\xc7\x4b\xa7\xc1\xa0\x58\x3d\x44\x7c\xff\xcb\x2e\x54\xfa\xd1\xdb\xe9\x22\xff\xe2\xcb\xcb\x96\xa3\x7b\xb0\x4f\x94\x7f\xf1\x65\x7c\x67\x3f\x32\x97\x81\xb0\xca\xeb\x4a\xac\x8e\xe2\x9b\x89\x98\xca\x4a\xdc\x8e\x71\x1c\x3f\x1c\xe4\x1c\xcb\x25\x76\xb8\x4e\xef\x8f\xc8\x3a\x30\xf5\xdf\x95\x75\x1e\xdc\x86\x37\xee\xdf\x8a\x37\x0e\x71\xe9\x87\xb2\x4e\x5b\x38\x2f\x20\xcb\xbe\xb2\x75\x25\x9a\x60\x5e\xd3\x3b\xcb\x65\x9c\x55\x62\xb6\x2c\x78\x05\x91\xaa\x4a\x28\x05\x12\x1b\x2f\xf1\x80\x24\xb1\x79\x76\x0d\xf3\xb8\xd7\x92\xe3\x68\x9e\x31\x95\x5e\x89\x39\x67\x84\x05\x2d\x79\x10\x8b\x90\xf3\xb1\xdd\xda\x9e\xe1\x6b\x4b\xc1\x19\xdf\x88\x7c\x76\xa5\xfb\x02\x35\x7f\xa7\xb7\xb7\xd4\x0a\x1f\xe1\xfc\xce\x33\x71\x0c\x32\xb5\xca\xd8\xaf\xd8\x4c\x6b\x91\xd1\xe8\xfb\x55\xe9\xa7\xc1\xfd\x38\x44\x1f\x2f\xe7\xcb\x02\xcf\xc0\x6b\x6a\x6f\xb7\xcc\x2c\x4c\x27\x22\x66\xda\x34\x44\x9d\x69\x59\x8b\x38\x70\x0d\xba\x22\x70\xcc\x64\xc5\x1e\xf4\x85\x29\x0e\x84\xe8\xcd\xa8\x51\x0c\x2e\xb2\xc7\x71\x41\x92\x2b\x10\x28\x21\xc3\xd3\xae\xc8\x1b\x5e\x66\x72\xee\xa6\xc0\xc1\xaa\x80\x07\xcd\xd6\x70\x64\x2a\x2a\xc1\x04\xdc\xe3\xac\x2f\x26\xe6\x98\x62\xb6\xa8\x24\xe6\x96\xc9\x92\x17\xe0\x83\x4a\x3c\x8c\x30\x84\x08\x6e\x9b\xe6\xd8\x51\xc5\xee\xc1\xa0\x09\xfc\x0c\x89\xce\x12\xc4\x66\x95\x3c\x2b\x75\x19\x1d\x5a\xae\x8b\x42\x1c\x6e\x14\xdf\xff\xf2\xb2\xf6\x26\xdf\x86\x91\xa3\xa3\x1f\xef\xea\xde\xb3\x52\xab\x83\xb0\xc7\xac\xfc\xe2\xcb\xf8\x32\xb0\xb9\x01\x12\x9f\x14\x22\x28\xcf\xce\x30\xcc\xc9\xb5\xe6\xee\x72\xa2\x39\xf8\x41\x51\x05\x5d\x93\x67\x2e\xef\x4e\xcb\xce\xfe\x19\x33\x65\xd3\xfb\x4a\x96\x97\x69\x25\xcc\x85\x15\xf2\x5b\x21\xda\x1a\xf4\x37\xcd\xb8\x6d\x68\xc3\x1e\xde\xc3\xd6\x31\x7b\x2e\x4a\xe2\x3e\x72\x39\xa1\x34\x06\xb1\x10\xda\x2e\xeb\x98\xed\x0e\x81\x50\x2a\xca\xc7\xec\xa7\xd0\x65\xc7\xf5\x45\x7e\xc9\xfe\x9d\xad\x2f\x7e\xba\x3c\x04\xe7\xec\x86\x2f\x3c\x38\x84\x0a\x00\x18\x9b\xfe\xa7\xf8\x1f\xf8\x91\x5f\xb2\xee\xa2\x5c\x89\x75\x2a\x0b\x89\xf2\x38\x20\x0e\xbe\x17\xeb\xc7\xf0\xba\x47\xe8\x1a\x67\xfc\x36\xb2\x0b\xa2\xd8\x51\x57\x80\xc5\xf6\xc1\xf7\x62\xbd\x5f\x10\x8f\xdc\x9b\xef\xc1\x72\x1f\x05\xc4\xdb\xc9\x09\xb3\xf8\x13\x65\x8d\xe5\x74\x25\xd6\xcc\x4c\xfa\x18\x29\x05\x31\x55\x8c\xb5\x93\x8a\x33\x32\xcb\x1c\x57\x97\x7b\xa4\x94\x1d\x3a\xa4\x1c\xfb\xa8\x6c\x84\x55\x67\x8d\xb4\x5e\x28\xcd\xf5\xb2\x4f\x31\x7e\x7f\x7e\xfe\xfa\x0c\x1b\x88\x8f\xab\x1d\x0f\xae\x92\x1b\x78\xff\x62\x6d\xb7\x9d\x0e\x41\x85\x04\x2b\x56\x83\xf4\xd7\x0c\xa6\xc8\x88\x08\x70\x7c\x7f\xd4\xd2\x6d\xb7\x1e\xed\x28\xf5\x7a\xb7\x3b\x7e\x05\x1d\x2a\xb5\xae\xc1\x5b\x5d\x80\x45\x8f\x39\x5b\xf7\x11\x2a\x58\xba\x04\x5e\xf9\x56\x69\x18\xc7\x90\xf8\x14\xef\x7b\x96\xff\x4c\xbc\xff\x63\xd9\x15\x5d\xe9\x2e\xde\xbb\xd5\xe4\x25\xcb\x35\x5c\xdd\x97\x15\x93\x2b\x72\x64\x3f\x34\xb6\x13\x50\xaa\x67\xe2\x3d\x2c\x93\x16\x55\x72\x26\xde\xb7\x37\x80\xb7\xf9\xa0\x6f\xb4\xc9\x45\x91\x05\xef\x29\xd5\xc9\x8e\xf6\x22\xde\xba\xb7\x5e\x42\x4d\xf9\x2d\x5d\xa4\xfb\x13\x02\x8e\xd6\x74\x2f\x8f\xc6\xb4\x17\xe6\xa8\x66\x42\x90\x40\x7f\xde\x4f\xa1\xbe\x64\x58\xd8\xa2\x2e\x38\x8b\xe6\x49\x13\x72\x1f\xad\xfe\xec\x11\xeb\xcf\x17\x98\x5a\x78\x3c\xc9\x02\xcd\xdb\x74\xcb\x6f\x45\x37\xe8\xb5\x97\x74\xed\x5d\x01\x37\xb4\x66\xb2\xca\x45\x9f\x6c\x7c\x5c\x37\x40\x4b\xd6\x76\x68\x9b\xb2\xcf\x4a\x6a\xb9\xe9\x5c\xdc\xe9\x4a\x17\x36\x11\x85\x2c\x67\xca\x7a\xd8\xe0\x53\x65\x16\xf4\xa6\x5f\xa2\xd4\x83\x44\xb6\x31\x29\x07\x6b\x02\x38\x92\xf7\x4e\xe3\x62\x7d\x79\x61\x3b\x87\x4d\x5b\x28\x34\x83\x07\xc6\x18\xfa\xec\xec\x29\x72\xed\xc7\x4c\x2d\xd3\x2b\xaa\xe9\xc4\xe6\x62\x3e\x11\x15\x1a\x5b\xdc\x9b\x48\xc8\x62\x12\x3a\x60\x2f\xc1\x9d\x63\xba\xe5\xda\xa1\x9f\x4d\x43\x86\x71\xbc\x02\x37\xed\x53\xd2\x33\xa1\x63\x07\x24\x40\x3c\x4b\x20\xda\x95\xab\x9a\xbb\x5c\xc9\x92\x15\x54\x2b\x59\xe3\x2f\x4b\x47\x4c\x1c\x26\xf6\xb1\xcf\xfc\x4a\x0c\x36\x7a\x69\x08\xaa\x84\xcd\x58\x6a\x94\xe0\x7a\x96\x61\x39\x34\x20\xec\x69\x1d\x4e\x77\x68\xfb\x42\xb6\x16\xa6\xa1\xf0\xa7\x7f\x68\xe3\x96\xce\x83\x6e\x45\x00\x90\xaa\x35\x8e\x8d\xc8\x10\x21\xb7\x5b\x97\x32\x02\xe1\x79\x5b\x48\xcf\xcd\x64\x4f\x54\xfc\x69\x6f\xe4\xfb\x50\xcc\xbb\xc6\x34\x8a\xdb\xf8\x01\x71\x5a\xf1\xed\x6e\x8b\x3a\xae\x5d\x83\xea\xc6\xb2\xbd\x77\x9d\xf8\xb5\x23\x5f\x5b\x12\x4c\x97\x3f\xff\xbc\x71\xd7\xcd\x02\x92\xe0\x5b\x68\xe0\x95\x40\x80\x0e\x4d\x31\xd0\xd7\xe9\x8d\x58\x14\x3c\x15\x70\x38\x67\x2f\x0a\xbf\x14\x37\xf6\x69\x34\xc2\xbb\xc1\xf0\xff\xfb\xf6\x8f\xb7\xf0\xcf\x28\xee\xbb\x57\x88\xa8\xf4\x14\x51\x28\xa4\x54\xa2\xd8\xb8\xa2\x6b\x05\x9f\x88\x22\x74\xf7\x0b\xd7\xf2\x31\x57\x62\xcc\x14\xdc\xe8\x56\x63\x76\xb5\x59\x5c\x09\xd4\x20\x10\xac\xcb\x44\xa5\x52\x59\x51\x14\x2f\x9f\x95\x12\xec\x25\x4c\x6d\x4f\xe5\x7c\xc1\x2b\xba\x0d\xe5\x09\x31\x5b\x46\xa7\x0f\xe7\x48\x39\x79\xb5\x3f\xc1\xd1\x5d\x22\xec\x5d\x84\xce\x2d\xfe\x7d\x94\x4f\xe8\x8f\x48\xc5\x71\xc7\xa8\xf2\xae\x9b\xd3\x93\x43\xa9\x92\xaa\x9d\x2a\xd9\xec\x30\x1a\x33\x15\x4a\x54\x51\x57\xb2\xd2\x68\x7d\x86\x39\xec\x0c\xde\x43\x11\xd9\xa3\xdd\x25\x07\xb1\x96\x3a\x34\xd6\x63\x7b\x86\x61\x25\xc9\x8f\x3d\x96\x35\x7b\xbf\x94\x5a\xb0\x04\xc6\x65\x4d\x11\xe3\xed\x96\x1e\xee\xae\xe4\xbc\x83\x74\xb0\xbc\xc9\x01\xa4\x87\x83\x0e\x22\x0f\x59\x0f\xd2\x01\x21\xe8\x70\x70\x02\x09\x04\x20\x8e\x43\x57\xf3\xc2\xc9\xd5\xf0\xec\x87\x37\xcf\xef\xa3\xbc\x82\x6a\xb7\x5f\x7f\xd5\x48\x78\xdd\x93\x73\x9d\xd8\x82\xbf\x66\x77\x28\x13\x9a\xe0\x8a\x81\x62\x07\xad\x48\xe2\x16\x5e\x62\x62\x10\xcf\x32\x38\xe9\xd1\x74\xc0\x9d\x59\x9c\x3c\xf8\x2e\x2b\xb2\xe1\x2f\x5a\x47\x86\x7c\x15\x82\x8b\xa2\x16\x80\x43\xe8\x8b\x02\x5f\xfd\x86\x83\x23\xd0\x11\xde\xa4\x6b\xdb\x1b\xfb\x6a\x2c\x7b\x83\xe4\xcd\x76\x75\xd9\x35\x53\x66\xad\x5e\x10\xcc\x93\x76\xd3\xea\xe6\x49\x3b\xe8\x34\xa5\x3d\xe3\xff\x7a\x91\xe2\x43\x53\x17\xea\x23\xc8\x87\x03\xe5\x1a\x3c\x42\xf4\x89\x0a\x30\x9c\x32\x28\x3d\x14\x96\x14\x2f\xf8\xe2\xaf\x62\x73\xc8\x61\xeb\x39\xc7\x3c\xbc\x9f\x3a\x83\x91\x3d\x48\xf5\x5e\x90\xd5\xaf\xc5\x26\xb8\x74\xa1\x28\x19\xa4\x6f\xfd\x08\xf7\xdc\x2f\x43\x62\xed\x47\x9b\xa5\xd6\xe9\xe4\x78\xab\xad\xbb\xe0\x1d\x64\xca\xd9\x84\x11\x93\x54\x87\xfb\xc3\x16\xa2\xd8\x9f\xb1\xd6\x87\x5f\xe7\xec\x09\x5f\xa1\x2f\x33\xf7\xec\x92\x6e\x8b\xe0\x89\x3b\x2d\x53\x1c\x3b\xab\xb3\xdf\xa7\xb1\x4b\x6a\x0b\xbb\x98\x29\x0d\x07\x83\x39\x54\x61\x38\xc5\xdf\x3e\x0f\xce\x69\xad\x5e\xe4\x0a\x23\x95\xfe\x36\x0c\xcf\xde\x8a\x24\x14\x1d\x57\x7c\x05\xb2\x83\x89\x12\x8a\x99\x90\x51\x38\xe7\x8b\xbd\x1e\x73\xd4\x8e\x6b\x9b\xb9\xc7\x16\x89\x9e\xb4\x50\x98\xce\x9c\xd0\x6c\xbf\xff\x40\xca\xf8\x39\x82\xf3\x46\x4a\xe0\xc0\x8e\xe0\xaa\xaa\xd2\x03\x70\x09\xad\x03\xe8\x88\x67\x5e\x05\x36\x9e\x97\xa6\xd2\xe7\x12\x7a\x59\x2e\xe4\x14\x52\xa6\x53\xc7\x29\xf4\x5a\x36\xc4\x64\x6a\x0a\x24\x13\x9d\xe9\x96\x79\x50\x43\x41\x2e\xa6\xb6\x12\x0e\xf8\x7b\x0e\x6e\x51\x79\x5c\x00\xca\x1b\xfe\xf8\x9a\xb9\x5e\xa7\x70\x04\xaa\xf2\x09\xd9\xca\x10\x6c\xd2\xf2\x86\x17\xb6\xda\x4c\x6b\x90\xb3\x42\x6a\x5b\x0f\xd6\x6e\x58\x22\x85\x2a\x64\xc0\xe9\x04\xb6\x4c\x8b\xa5\xdb\xf0\x8a\x8a\x5d\xcb\xd2\x56\x94\x09\x8e\x00\xf2\xb1\xce\x03\x5b\x51\x96\x97\x6d\x69\xf2\x99\x86\x58\x8d\xb7\xce\x0d\x1b\x0e\xec\xf6\x01\x8f\x71\xd8\x90\xa8\xad\x78\x97\x3b\x52\x16\xef\x59\x2b\xd8\xe5\xac\x17\xd0\x43\xa3\x11\x95\xef\x63\xbb\x71\xeb\xa0\xb8\xd9\xd0\x16\xc8\x6e\x1c\x74\x6e\xb7\x8c\x4a\x35\xbe\xe1\x37\x38\xca\x2f\x64\x2b\x35\xeb\x60\x5b\x03\xca\xb6\xf2\x52\xfc\xcd\x05\xd8\x7a\x74\xeb\x02\xed\x95\xfb\x7f\x87\xe5\x73\x45\x47\x7d\xd9\xba\x77\xa9\x82\x02\xa4\x6f\xfd\xa2\xb7\x31\x82\x51\x68\xfb\xd8\x12\xe6\xf8\x04\xed\xaa\x05\x57\xca\xee\x0f\x17\x49\xc7\xf5\x82\xc0\x95\x5d\x28\xa8\xe4\xa0\xa5\x21\x31\x6d\x87\xee\x54\x5c\x75\x53\xc3\x06\xb6\x81\x61\x02\x53\x40\x89\x38\x60\xec\x00\x03\x07\x34\xca\x9f\x02\x66\xbd\x82\xca\x70\x35\x08\xa3\x69\x19\x41\xcb\x84\x6e\xd8\xe1\xdf\x54\x29\x0a\xfe\x24\xf0\x3d\x75\x73\xd4\x46\x69\x01\xd5\x60\xb8\xea\x0d\x4b\x9d\x61\x9b\x47\xd4\x06\x85\x90\xd7\xad\x23\x88\x42\xfe\x98\xac\x7a\x3c\xc8\xbd\xe5\x42\x30\x01\xd6\xae\xa2\xc5\xd1\x0b\xc1\x12\x73\xb8\xb0\x3a\x59\x7e\x88\x1b\x2c\x31\x5e\x63\x58\x58\xe3\x17\x68\xa2\x08\x68\x6f\x81\x29\x5a\xd1\xf0\x1c\x22\x33\xed\xc6\xfa\xed\xb5\x04\x09\xe7\x1e\xe9\xd7\xa0\xeb\x85\x81\x1d\xcc\x28\x39\x64\x02\x7a\x2e\xa2\x81\x62\x64\x7c\xb3\x9b\x25\x20\x58\x81\xd8\x28\xb6\x45\xbd\x9d\xb1\x4a\x2d\x4c\x69\xc0\xc3\x96\x69\x80\x48\x11\x74\x0d\x89\x67\xb0\x28\x82\x87\xa9\xff\x1b\x5e\xf8\xfa\x0a\x5b\xba\x28\x71\x70\x75\xba\x10\x82\x69\x3c\x84\x66\xf3\x45\xf4\xc0\xd6\x50\x7a\xa6\x68\xec\x83\x11\xd4\xbc\x8d\x59\xbf\x1a\x34\x40\xa3\x4e\x88\x74\x0d\xd1\xbe\x66\x63\xd3\x30\x40\xab\x45\x25\xb5\x25\xd6\xb9\x7c\x5d\xc9\x7a\xc7\x04\x3d\x1f\x3a\xc4\xc7\x6e\x93\xe5\x94\xa5\x72\x09\xc7\xcf\x70\x15\xa4\x8e\x7c\x23\x18\x23\x7f\xfa\xb1\xa7\xd1\xa2\x38\xd4\x2d\x40\x52\xef\x2d\xe4\x7c\x87\x04\xfb\xb7\x95\x9c\xb7\xa6\xc0\x43\xfd\x6d\x2a\x42\xb3\xb7\x3f\x17\x42\xbb\x07\x7c\xb4\x0e\x41\x3d\x9e\x2d\xd6\xa1\x95\xa0\xc4\x7a\x5a\x8b\x17\x07\xb2\xfd\x03\xb9\xfe\x7d\x84\x3e\xf6\xea\x81\x49\xe4\x8f\x6a\xcb\xa7\x71\x9f\xf8\x96\x17\x10\x0e\x5f\x63\xdc\x73\x93\xa0\xa4\x4f\xb2\xf8\x77\x06\x30\x92\x7a\xbb\xba\x54\xed\x9b\x05\x87\x2f\xca\xd5\x77\x06\x36\x7c\x5e\x50\x41\x46\xbb\x60\xb3\xf7\xc5\x4c\x94\xcd\xf5\xfa\xee\x6f\xcf\xdb\xb4\xa1\x66\xd4\xc0\xbf\xc0\x32\xc6\x58\xa6\xd5\x39\xcd\x59\x40\x1c\x01\xca\x81\x4b\xed\x4a\xc5\x1d\x5c\xe1\xef\xfe\xf6\x3c\xba\x61\xb9\x4c\xfe\x5e\xc1\x39\x19\xae\x2d\xb8\xef\xdf\x62\x40\x3c\xba\xc1\x72\x8e\xa9\x2c\x57\xc9\xdf\x96\xb2\xb9\xd2\x71\x7b\x95\xfb\x27\xe2\x9a\x84\xee\xe2\xec\x5b\x68\x40\x6f\x55\x77\xd9\xee\x5a\x2b\x6d\x35\xc3\x2a\xa1\xaa\x9c\x71\x48\x3f\x35\x94\xd1\xf9\xfe\x78\xc4\x68\xcc\x56\x54\x9c\xe3\xf7\xe0\x19\x2d\x3b\x3c\x63\xbf\xca\x54\x73\xcc\x37\x67\xaf\x5e\xa2\x3d\xdd\x26\x37\x36\xb5\xa5\x9a\x5b\xfb\x0a\x56\x52\x56\xfb\x38\xe7\x48\x96\x71\xa3\xc3\xa5\x24\xfb\x75\xa7\x04\xe4\xd9\x98\xf5\x0a\x0a\x68\x97\x10\x00\xd3\xd9\xe3\xa4\x36\x23\x1d\x33\xbf\x5b\x4a\x8e\x1a\x79\xcd\x5a\xb8\x87\x2f\x45\xfb\x5c\x06\x1d\x92\x37\x1c\xae\xf8\x2d\xc5\x16\x7a\x3d\x64\xda\x5d\x0d\x81\xfe\x3b\x9a\x14\x3e\x7a\xf5\xd7\xe8\x20\x3b\x76\xae\x4e\xc3\x18\xec\xce\xa1\x9b\xd3\x76\xb1\xe0\x12\xef\x6f\xce\xad\xde\x07\xbb\x02\xd6\xf8\xeb\xd9\xda\x3b\x18\x42\x67\xad\x63\x82\x83\xfc\x0e\x2e\xb0\xf9\xc6\x57\xe2\xde\x37\x24\x9f\x92\x0c\x86\x05\x36\x26\x3b\xbb\x39\xb2\x2d\x5d\x40\x36\x3b\x04\x08\xfa\x99\xd9\x0d\x01\x5c\xec\x0d\xeb\xf3\xee\xa1\x8f\xe9\x84\x16\xd7\x03\xf5\xab\xaf\x5f\xfa\xb0\x0c\x5f\x3d\x84\x75\x47\x8e\xcb\x33\x53\x7e\xb5\x71\x15\xf3\x2c\xe5\x65\x48\xd7\x7a\x80\xa0\x49\xd9\xd4\x28\x2e\xb5\x19\x9a\x7a\xc4\x63\x69\xab\xf2\x75\xb1\x41\xcf\xf4\x11\x7b\xf9\xc3\xf3\xe7\x78\x21\x1b\x26\x33\x11\x4c\x21\xd0\x0c\x80\x86\xce\xd6\x7a\xb7\xa5\xc5\x37\x5a\xf9\x28\x7a\xbb\x0f\xe8\xbb\x82\x90\x40\x9e\xf9\x44\x0e\xec\x20\x40\xc1\xe0\x15\xd8\x39\xf4\x65\x1f\x28\x05\x73\x60\x9b\xac\x68\x03\x77\x4b\x2d\xd6\x1b\xc3\x62\x61\xdd\x0d\x0b\x36\xc8\x29\x76\x3b\x74\x8e\xe8\xdc\x48\xb5\x27\xd3\x3f\x44\x60\xde\x47\x1c\xbc\xb9\x21\xba\x1b\x18\x22\x06\x09\xdc\xc0\x03\x77\xa6\x12\x8b\x8a\x45\x00\x2a\xc1\x4f\x81\xe4\x29\x84\x1a\xf4\x55\x25\x97\xb3\xab\xb8\xa9\x7b\x30\x9d\xbd\xc5\x5e\x00\x27\x64\x5d\xd2\xad\x31\xcf\x63\x6a\x7e\x00\x6b\xbb\x6d\xa0\xb0\xcf\xf2\xf7\x46\x0f\x1b\xa4\xf9\x34\xe4\xc3\x44\x0f\x1a\x65\x5f\xc8\x5c\x6d\x9f\xf7\x37\xe8\x80\x97\xf7\x5a\x74\xff\xc9\xd3\x5e\xfb\x14\x57\x2f\x71\x82\xda\xea\xe4\xa4\x4b\x01\x58\x53\xa8\xfa\xcb\x78\xbf\x4f\xd7\xaf\xdd\x60\xfc\x68\xd2\x55\x62\xae\x7e\x3a\x14\xca\x39\x3d\xed\x24\x3c\xb4\x08\x50\x33\x63\x87\x9a\x5d\xd6\xb4\x5f\x09\xa3\x11\x9c\x8a\x79\x78\xca\x00\x6c\xe2\x90\x8b\x26\x63\x76\xd7\x2b\x63\xda\xaf\x83\x7e\x63\x5d\xa6\x2b\x5e\xaa\x82\xfb\x09\xd5\x66\xdf\xfc\x1d\xa2\x14\xbe\xef\x6f\x5b\xd2\x47\xdd\x02\x2a\xc8\x94\x36\xa8\x9b\x29\x4c\x9e\xb4\x0c\x73\x74\xba\x64\x3d\x7e\xe4\x03\xeb\x3f\x08\xef\x0f\x69\xfb\xfd\x7f\x65\x0c\xbb\x2b\x1c\x7e\x95\x6c\x08\x5e\x90\xf2\xc4\x83\xf9\x3e\x1e\x18\x00\x33\x51\x41\x52\x7a\x5d\xe3\x63\x51\x09\xb8\x23\x0d\xdf\x4a\x20\x44\x38\xc3\x7b\x5a\xf7\x75\x95\x2f\xfa\xe9\x7a\x50\x8c\xd8\x6b\x51\xad\x1d\xf1\xa9\xe4\x8b\x17\x75\xfe\x90\x92\x0f\x07\x6a\xd4\xb4\xd0\xb1\x1e\xdc\xb7\x50\x57\x1b\x2b\x1e\x45\x50\xe2\xe8\xeb\xaf\xa2\x75\x3c\x66\x5f\x3e\xb0\x4e\xfb\xa0\x19\x8d\xdf\x0b\xe5\x59\xa9\xa3\x3d\x30\x68\x4a\xbf\x81\x04\x85\x9b\x0c\x33\xc8\xb6\x03\xb6\x40\xd3\x30\xb3\x35\xc5\xe1\x33\x1c\x54\xfe\xcd\x70\xcd\x11\x05\x3c\x6e\x25\x5e\xf7\x31\xcd\x27\x93\xbb\x2d\xd6\x81\x13\xd7\x89\xfb\xea\xe1\xe4\xe2\xc1\x25\xb8\xa4\x9f\x8f\x3e\x3f\x8a\x61\xfc\xea\xd3\x76\xa1\x51\xf2\x22\xb7\xb8\x39\x00\xb7\x8c\xd9\xd7\x5f\xc5\x1d\x5e\xe9\x05\xf0\x6c\x6f\x7f\xc2\x3f\x20\xca\x6f\x63\xf2\x3c\x64\x77\x6e\xa0\x12\x1b\xda\x05\x74\xce\x19\xa4\xe7\x8a\x17\xff\x4f\x6a\xb2\x99\xb4\x1f\x3e\xed\x71\xce\xbe\x93\x2f\xe9\x6b\x9b\xbd\x9a\xe4\x40\x96\x7b\x4f\x1e\xc5\xc1\x9b\x25\xcd\x37\xee\x8a\x09\xed\xff\xef\x64\xb8\x82\x8b\x7d\xde\x74\x58\xea\x22\x3d\xd0\x06\x6b\x61\xf3\x52\x9b\xb5\x83\xb3\x96\x3b\xff\xdf\xaa\x5f\x05\x58\x90\xc7\x9f\x02\x13\xd1\x0e\x2b\xcf\x23\x3f\x13\x1a\x8e\xfc\xac\x1b\x81\x1f\x2f\x22\x24\xd6\xda\x54\x87\xaa\x3f\x67\x4b\xcb\x0d\x99\x87\x85\xd0\x7d\x29\x74\x8f\xcd\xeb\x9e\xba\x19\x7f\x8c\xdb\x0c\x84\x63\x9d\x39\x65\x8e\x55\x9b\x8d\xd8\xcd\x95\x54\xc2\xee\x4d\x0e\xc7\x0c\xad\x6c\xaa\x05\x72\xd7\xd8\x24\x66\xc2\x9a\x82\xeb\x4a\x3c\x10\x1e\x30\x32\x5d\x88\x07\xc2\xb9\x1a\xd4\xe4\x94\xb5\x7d\x37\xf3\x22\xa6\x6c\x0e\x70\x9f\x85\xba\x4d\x36\x07\x21\x83\x2b\x44\x19\x1d\x76\xa8\xef\xb9\x32\xd4\x8c\xda\x83\x7b\xda\x73\xcc\x08\x13\xca\xfa\x20\x4c\xea\xac\x0f\xf3\x20\x98\xf5\x61\x5e\x05\x84\x88\x58\x2f\x60\x5a\xa1\xc3\xb0\x1f\x39\x16\x28\x84\xc3\x67\x6c\x94\xc0\x03\x9b\x7b\xd4\xf6\xfb\xc7\x6c\xb1\x9c\x14\xb9\xba\x82\x54\x3f\x13\x99\x41\xed\x4e\x01\x6a\x58\xcd\x60\x52\x3c\xc0\xac\xf3\x14\xe6\x4b\xf3\xc1\xbd\x37\x7f\x7f\xb1\xd4\x62\x0d\x85\xbd\x5a\xed\x89\xaf\x20\x67\xba\x3f\x34\x04\xdf\xe5\x32\xd8\x58\xc9\xb0\x6a\x2b\xfa\x1f\x79\x65\xbe\x0b\xdb\x95\x19\xdb\xe1\x60\x95\xcc\x97\xc9\x73\x99\x5e\x43\x4c\x2f\x13\x53\x51\x31\x7c\xf4\x43\x59\xd0\xc3\x55\x02\x9a\xc6\x56\xa4\xea\x96\x22\x4f\x97\x55\x25\x4a\xb8\x3a\x4e\x66\x4a\x73\x94\xfd\x78\xd9\x50\x55\xf3\x95\x43\xec\x4d\x00\xb3\x37\x35\x6a\x47\xd6\xcb\xf2\x16\xd5\x49\xdb\x03\xe4\xea\x8a\xd2\xc9\x98\xbd\x75\x2a\xd3\x5a\x7c\x18\xc3\x59\x8a\x28\xf6\x2c\x3f\x8b\x95\xb3\x0e\x02\xbc\x98\xaa\x15\x31\xe2\xe3\xb3\x1f\x09\x69\x9f\xa6\x2d\x72\x60\x18\xfb\xf1\xd9\x8f\x6c\x0a\x37\x60\xc6\x78\x16\x42\x89\xd9\x36\x53\x28\xb5\x37\x2b\xd2\x2b\x5e\xf1\x54\x83\xe9\x88\x49\x60\x95\x78\xbf\xcc\x21\xb7\x5b\xf7\xeb\x0e\x87\x44\x63\xc6\x4a\x53\x49\x65\xfb\x0e\x2d\x87\x3f\xd9\x7d\x6b\xaf\x61\x3c\x2a\x37\xb0\x97\xc7\x6c\x34\xfe\xe7\xe8\x9f\xd5\x3f\x4b\xfa\xe0\x58\x58\x97\xbc\x1b\xbd\x63\x5f\xd0\x20\xca\xa6\x6c\x3f\x2a\x0a\x03\xe2\xdd\xe8\x1d\xfc\x33\x7a\x17\xb3\x2f\xd8\xbb\xd1\x3b\x5a\xd6\x80\x89\x01\xd4\x08\xa7\x36\xb4\xe8\x04\x09\x44\x15\x9c\xd2\x8f\xc3\x09\xf2\x7d\x79\x07\x8f\xcf\x7e\x8c\x10\xcc\x31\x09\x07\x64\xa8\x62\x7b\x2c\xd9\xfb\x67\xb0\x56\xbb\x32\x8f\xf0\x7a\x07\x13\x6c\x36\x38\x5b\x4e\xdb\x0d\x40\xf6\xe1\x6f\x76\x1a\x22\x18\xbe\xba\xf8\xf2\x61\x3d\xf0\xfd\x2f\x2f\x0d\xf5\xe0\xdf\x77\x8d\x90\x6b\x60\x82\xd4\x29\xc0\x9d\xef\x97\xa2\x82\x6b\x12\x7c\x4e\x4c\xfa\x37\x78\xf0\x1a\x1f\xec\xe1\x52\x4a\x30\x54\x64\xae\xcc\xe9\xde\x3e\x19\x97\x05\x94\xc1\x2f\xc7\xf0\x06\x0b\x6e\xa3\xa6\x5b\x56\x05\xe9\xe2\x7e\xe6\xac\x07\x6f\x70\x27\x4d\xcc\xe3\xce\x5e\x5e\xf1\xd0\x0f\xb3\x0c\x4e\x18\xbe\x5d\xc6\xe7\xf0\x9d\x61\x0a\xea\x05\xd9\xc5\x8a\x40\xda\x5d\xe0\x7e\x29\x9d\x17\x05\xfb\xe1\xcd\x73\x26\x54\xca\x21\xb7\x09\x9e\x2e\x4b\xfb\x8b\x4a\xaa\x34\xbf\x86\xba\x17\xcd\x88\xbe\xf3\x7c\x98\xf1\xf6\x17\x98\x43\x30\xb5\x1d\xde\xfd\xc0\x8e\x97\x5c\x92\x4f\x6b\x94\xc7\x6c\xf9\xd4\x40\x85\x05\x42\xf2\xfd\x40\xef\x08\xe6\x5f\x4c\x0b\x82\x78\xf7\xae\x37\xdd\x3f\x9d\x12\xfd\xbc\x71\x42\xc8\xb9\x1e\x0d\x46\x35\x13\x0a\x30\xe5\x5c\xe8\x2a\x4f\xf1\xa6\x4b\x5f\xc2\xd4\x73\xf3\x12\xdc\x22\x86\x0d\x9b\x07\x34\x7d\x3d\x68\x3d\xe9\x9b\xa7\x81\x8e\x27\x27\xac\x6e\xd8\xd0\x7d\x4d\x68\x60\x0e\x70\x56\x7f\x26\x55\x95\xfc\x5a\xbc\x05\x93\x8d\x96\x12\xee\xd3\xe5\x26\x1e\x07\xdb\x80\x43\xc8\xb4\xca\x53\x83\xac\x0d\x87\x06\x23\x48\x45\xc1\xd4\x15\xa7\xc2\x3c\xa3\x65\x89\x55\x4c\x47\xa6\x23\x0a\xb6\x6b\x21\x16\x54\xc9\x07\xee\x02\xa5\x9c\xbe\x09\xa0\x37\x80\x50\xff\xee\xaa\x27\x76\xbc\xe3\x80\x7d\x8e\x08\xba\x39\x3c\xfb\xc5\xb8\x47\xd7\xf0\xd6\xec\x52\xe8\xc3\xc4\xb8\x37\x3f\x24\xd6\x31\x9b\x6a\xcf\x45\x82\x1a\x9c\xba\x40\x78\x47\xe4\x6b\x7d\x40\x1a\x59\xc8\xfb\xf7\xe7\x0e\x85\xa4\xe1\x57\xdb\xdd\x82\x28\xcd\x9c\x2f\x8c\x79\xb9\xac\x6c\xb8\xb7\x09\xe8\x89\x80\x6b\x19\xf0\xe9\x47\xc7\xc3\x10\x35\x82\x87\xe6\x4b\x85\x8d\x03\xb3\x59\xae\xaf\x96\x93\x24\x95\xf3\x93\x79\x0e\x36\x75\x51\x5c\x9d\xf8\x63\xc0\x00\x35\xc8\x6f\x97\x65\x8a\x81\x3a\x88\xc4\x70\x78\x6f\x04\x24\xad\xa4\x3d\xbd\x0c\x1e\x00\x13\x97\xd3\x22\xf6\x21\x1d\xc5\xe6\x62\x31\x86\xa2\x2b\x31\x2d\x44\xaa\xe9\x88\x5b\xcb\xd6\x03\x38\xb3\xae\x3d\xeb\x2d\x7d\x19\xc5\xfe\xf2\xd7\x9a\xd6\xe8\x13\x40\x86\x3d\x04\xb8\x26\x7f\xcd\xcb\x2c\xc2\xca\xe3\x16\x14\x59\x7c\xbf\xfc\x02\xbc\xec\x3d\x87\x31\x5f\x4d\x5b\x9c\x19\x3d\x88\xe9\xba\x73\xb7\x5a\xbb\xff\x45\xd5\x00\xf3\x47\x16\x30\x8a\xb8\x57\x53\x53\x1e\xdc\x69\xcc\xbe\xdc\xd4\xf7\x45\x96\x15\xae\x5e\xbf\x7a\x6f\x25\xe4\xc3\x53\x73\x5d\xf8\xfe\x6e\xf7\x31\x9d\xec\xfb\xec\x33\x9b\xdd\x44\x0d\x1a\xd9\xcd\xc1\x64\x69\xd3\xe3\x94\x92\xa6\x3f\x2b\x5b\xb9\xd0\xc3\x41\x0b\x75\xeb\x3a\xfa\xcf\x22\x1b\xb3\xfc\xfc\x8e\xfa\x7c\xc4\xa2\xca\xd8\x56\x6c\xf4\xf9\x88\x8d\x3e\xff\x7c\x64\xd0\x8a\xe3\x66\x1a\x75\x3d\x06\x06\x68\xda\x02\xe2\xec\x6f\xcf\xdd\x90\xdb\x2d\xfb\x49\xe6\x25\x1b\x8d\x47\xfe\xb8\xbf\x34\x82\xa5\xa4\x60\x3a\x50\xf0\x0b\xa3\xde\x46\x7d\xfc\xfd\xd3\xc7\x7f\x85\x7c\x44\xa5\x2b\x0e\x55\x8d\x8a\x7c\x5e\x67\x4c\xa5\xb2\x58\xce\x4b\x7b\x4d\xf3\xf8\xed\x65\x07\x8a\x08\x80\x95\x8e\x1d\x3b\x6b\x64\xc6\x8f\x46\xec\x0b\x3b\xd8\x17\x6c\xc4\x9e\xbd\x34\x8f\x7a\xa9\xf0\x05\x7c\x11\xd7\x2a\x80\x66\xa3\xd7\x52\xe9\x59\x25\x14\x7c\xe9\xee\xc9\x93\xe7\xfe\x5c\xdf\x3c\x7d\x74\xfe\x94\x9d\xff\xd7\xeb\xa7\x10\x18\xd1\xe8\xcb\x91\xca\x5c\x50\x2f\x06\xc3\x31\xf4\xb3\xad\xa7\xfe\x61\x53\x6f\x0d\x1f\x01\xa8\x97\x75\x98\x34\x48\x03\x0f\x2f\x98\xb5\xeb\x02\xa4\x78\x74\xc6\x9e\xbe\xfc\xe1\xc5\x11\xf4\x18\x75\x37\x1d\x7c\xd3\x58\xbd\x2f\xf0\x9f\x72\x59\x14\xb0\xc0\xf6\x6f\xa5\xab\xb0\xbd\xf3\xb4\xaa\x5e\xe6\xc5\x6b\x0d\x97\x8e\x51\xa2\xe1\x9d\xe3\x68\x84\x9b\x88\x2d\x24\x0a\x26\x08\x6c\x94\x79\x31\x8a\x19\x66\x66\x0b\x06\xd5\xd3\x01\x71\xa4\xe7\x82\xa7\xd7\x7c\x26\x58\x5a\x70\x75\x25\x94\xcb\xb6\x68\xbb\xd0\x9d\xf4\x8a\x3d\xe7\x0a\xd0\x96\x2c\x58\x4f\x34\xc6\x0c\x3e\x83\xe9\xc9\x47\xb8\x8b\x8f\x8d\x3c\xb3\xf4\xc0\x21\x01\xc8\x2b\xfc\xa6\xdf\x23\x76\x93\xc3\x35\x4d\x23\x81\xa0\xfa\x13\xe0\x87\x86\x15\x4c\x4d\x25\xd8\x2a\xab\xf2\x95\xa8\x8c\x1c\x22\x4e\xb0\x97\x33\xbd\xfc\x74\x14\x69\x40\x0b\xb1\x5e\x88\x2c\x17\x65\xba\x19\x0e\xd4\x0d\xe8\x3c\x53\x40\x00\x7b\x26\xc8\x1f\x88\x38\x1a\x74\x78\x48\xf4\xb0\x07\x65\xc8\xb4\xf3\xcc\x3e\xd3\xcc\xd6\x6e\x0e\xc9\xe9\x55\x8c\x5f\xb6\xf6\x57\xbf\xef\xfc\xe0\xe4\x04\x3f\x4d\x4d\xde\x04\x7d\xc7\x0d\xcf\x8a\x88\x9c\x5e\xce\x1b\x15\xce\xc0\x43\x8c\x15\xf9\x09\xdb\x6d\xff\x89\x49\xf7\x88\x64\xe5\x8e\x36\xea\x5b\x28\xb6\xd5\x23\x2d\xf3\x68\x15\x3b\x81\xf8\x17\xb6\x6a\x79\x19\xfe\xb4\xdb\x33\xe6\x85\x3b\x5a\x43\x2d\xe6\xc2\xa9\x86\x72\xe6\x30\xea\x30\xe5\x28\xca\xb2\x8a\xff\xa8\x14\x74\x08\x1e\xa2\xa4\x3f\x93\x4f\x41\xd1\x66\x73\xc7\xba\x2b\x7a\x9d\x97\xfa\x20\x3b\xb7\xb6\xfa\x43\xaf\xa2\x46\x99\x17\xbe\x8d\xd2\x27\xa9\xc8\x64\xc1\x51\xee\xd9\xa1\x97\xc7\x8c\xbd\x3c\x6e\xc7\xdd\x23\x58\xbf\x02\xaf\x16\xe8\x7b\x0d\xd8\x5f\x7f\xf5\xa9\xa0\xe3\x59\xdc\xcb\x25\xd4\x58\x79\x48\xe7\x88\xbd\x6c\x86\x47\x9b\xc8\xae\xf8\x55\x9f\xaf\xbf\xf2\x8f\x2a\x43\x47\x9d\x2b\x67\xf4\x75\xce\x2a\xbd\xb3\x4e\x03\xf1\x10\xc0\x67\xfb\xe1\x95\x59\xef\xce\xbb\xfd\xd9\xe7\xea\xc8\xb3\x4f\x5c\xa7\x69\x21\x39\x48\x67\xd0\x78\x7e\x9e\x06\x9d\xc2\x68\xf4\x71\x70\x93\x53\x4b\x90\x88\xb9\xfe\x1c\x9e\x94\xb8\x00\x7d\x63\xd8\x11\xee\x7d\x94\x21\x3e\x09\x8f\xda\xcd\xf4\xc9\x80\x7f\xba\x1d\x70\xaf\x56\x97\xb7\x05\xbf\x4f\x55\xdc\xfb\xc3\x6a\xd9\x7b\x07\xd5\xec\xbd\x4f\xa0\x67\x77\xc3\x81\x33\x6d\x87\xbd\x96\xa8\xd2\xde\xc7\x76\xba\x99\xcd\xc6\xc6\x62\xed\xac\xe6\xda\x3c\x6c\xe2\x53\x9f\xf8\x44\xbe\x75\x16\xf0\xc8\xeb\xc0\xae\xcd\x04\xae\x49\xf3\xdb\x63\x53\xe7\x04\x75\x32\x14\x1a\x76\x3c\x50\x2d\x5b\xf6\x7e\xa2\xf9\xf7\xb5\xd8\xdd\x01\x99\xc9\x2a\x02\xe6\x16\x2e\x23\x13\x7c\xdd\x70\xf2\xdf\xd8\xb2\xbe\xe9\xa6\xf9\xb5\x49\x73\x85\x93\x4f\xfb\x79\xc9\x12\xee\xd1\xea\x2b\x06\xdf\xf3\x67\x13\x57\x9d\xe0\x77\xf4\x12\x5a\xda\xec\xa0\x41\x7f\xbc\xa9\xee\x0d\x74\x4b\x53\xb5\x0d\x21\x2c\xf8\xd8\xc7\x94\x7c\xed\x21\x9b\x2d\x60\xda\x40\xac\x53\x62\xf4\x5a\x24\x77\xdb\xdd\x12\x43\x1f\x7c\x2d\xf2\x9d\x3e\x31\xaf\x5b\xc6\x68\x10\xa5\xbc\xd4\xff\xf6\xe7\xde\xb7\xb5\x86\x0a\xbe\x0e\x9a\x70\x1f\x3e\x0d\x30\x5d\xa9\x10\xe7\xc3\x61\xd0\xae\xf1\xd3\xf7\xef\x9c\x07\x93\xf7\xc7\xcc\x9e\xcf\xec\x86\x07\xd3\x24\x9b\x4f\x50\xac\x53\xe2\x24\x7c\x66\x1b\x26\xd5\x0e\x1b\x42\x13\x8c\x3a\x02\xca\xdb\x30\x96\x47\x5c\xdd\x00\x30\x75\xae\x56\x60\x0c\x2b\x23\x6b\x15\x22\xde\xd7\x72\x70\x94\x97\x7a\x74\x0b\x91\x7d\xe8\x42\x20\x08\x9f\x86\x46\xfe\x14\x32\xfe\xb6\xfa\xe6\x18\xe4\x41\xde\x7e\x1a\x2d\x69\x14\x52\x5b\x31\x4d\x0b\x3e\xa3\xa9\x40\x4a\x49\x6b\x22\xdf\xc9\x82\xc3\xf5\x98\x82\xcf\x28\x5e\xe2\x26\x83\x51\xf7\x7d\x82\x5c\x68\xe0\x03\x32\x86\xbc\xf4\xd1\xd5\xa1\xb3\xc9\x98\x98\x6a\xe5\xa6\x03\x79\x81\x94\x39\xb7\x1f\xc7\xef\x84\xd6\x3e\xc5\x0f\x21\xf9\x9d\xa0\x62\xf0\x56\xd1\x78\x34\xbc\x67\xb3\x47\xd0\x3e\x6b\x0d\xea\x1d\x83\xa8\xc5\xf4\xcb\x7f\x3b\x59\x7c\x0b\x84\x6c\xd1\x68\xcf\xc8\x00\x34\x74\x70\xdd\x4a\xa4\xeb\x8f\x09\x5a\x53\xb5\x65\x90\xa1\x41\xf0\x72\x59\x14\x4d\x38\x94\x62\x84\xd9\xbb\xfe\xf3\xd6\x4f\xfc\x1c\x56\x9e\x31\xb0\x1d\x07\x70\x67\x7e\xbb\x3d\xb9\xc7\x1e\x65\x19\x53\x72\x0e\x13\x9b\x4a\x60\x54\x2d\xbd\xfb\xf9\x39\xa9\x7b\x76\xc3\x15\x96\xe3\xc8\x96\xc0\x7a\x5e\x0a\x33\xfc\x32\xc9\x16\xec\xde\x09\x84\xe3\x5b\x97\xb9\x07\x67\x42\x0f\x06\xde\x98\xd6\x5b\xb4\xd5\xd4\x5f\x8a\x9b\xee\x94\x22\x52\xe3\x9e\x8d\xb0\x66\xdd\x66\xb8\x2d\xd6\x89\xb5\x2b\x30\xde\xb9\x81\x24\xb1\x1b\x5b\xd8\xd0\xcc\x01\xf9\x73\x0c\xc9\x09\x37\x70\x6e\xff\x13\x59\x2c\x50\x73\xb8\x34\x32\x90\xe4\x09\xad\xd4\x70\xf7\x21\x36\x96\xe3\x83\x10\x82\x47\xda\x3c\xe4\xfa\x7b\x94\x5b\x27\xb0\x67\xe1\xd2\xc3\xb2\xfe\x1a\x4b\xd8\x38\x5a\x27\xcd\x51\xc7\x6c\x4d\x17\xcd\x3a\xd2\x1b\x6b\x9a\x41\x3d\x15\xa7\x1c\x40\xd0\x0f\x07\xc6\x90\x68\x03\x72\x94\x45\x95\x55\x03\x8d\x6a\x67\x24\xa0\x0b\x7c\x0e\xfe\x70\x41\x5a\xd3\x33\x44\xce\x83\x42\x12\xd2\x95\x08\x51\x4f\x01\x96\x79\x41\x1e\xd1\xae\x1b\x26\x36\x75\x4a\x50\xb7\x7c\xfd\x15\x1a\xdc\x80\xb9\x3d\x46\x68\xa9\x8a\x16\x85\x3e\x82\xe6\xf8\xf4\x13\xa6\x67\xdd\xd5\x0d\x78\x5b\x66\x73\xda\x95\xf4\x36\x72\xeb\x6e\x4c\x2a\xab\x4a\xa4\x98\x02\x28\xaa\x9c\x17\xf9\xcf\x70\xeb\x25\x30\x05\x38\xa0\x82\x1e\x76\x9a\x65\x70\x9a\x07\x6f\xb3\xe0\x29\x18\x03\xb6\x3a\xc3\xc3\x8f\x11\xfc\x39\xc2\xfd\x50\x12\x5f\x7a\xd3\x6f\x64\xec\x95\xed\x35\xf3\x89\x42\x57\x42\x08\xb0\x23\x45\xf7\x26\x47\x3d\xe1\x4c\x1c\x9a\x32\x9c\x01\xb7\x26\x7d\x2f\x34\xeb\x83\xd7\x31\x4a\x4f\x08\x0c\x31\x5a\xb7\xae\x19\x67\x6b\x78\xd9\x1c\x97\x53\x88\x49\x81\x08\xf7\xc2\x8a\xe6\xaa\x11\xd7\xac\xe0\xd5\xcc\x9d\x88\xd8\xcc\x91\x1c\x4e\x40\x78\xaa\x59\x96\xcf\x72\xad\x12\xb0\x70\x53\x97\xf1\xf8\x52\xdc\x18\xd8\x55\x04\x68\x51\x75\x5b\x8e\xbf\x21\xe9\x31\x13\x69\xf2\x83\x12\x26\x7e\x09\xa9\x82\xa4\xfa\xe1\xb9\xe9\x18\xdd\x5d\xb7\xaf\x04\x04\x6e\x04\x18\x33\xd8\xeb\x7c\x2e\xaf\xe1\x53\x1a\xee\x46\x42\x2e\x93\xa7\xaf\xbe\xed\x33\x68\xad\x08\x07\x7a\xe3\xee\xed\x52\x7a\x0c\xb9\x4a\xf6\xc2\x39\x1c\x88\x7b\x1f\x4b\x43\x92\xd0\xad\x5a\x18\xef\x94\x95\x46\xec\xad\x9d\x68\x73\xe9\x49\xfe\xf6\xf0\xfe\xb4\x97\x50\x3d\xb9\x77\x9c\xe6\x3e\xd3\x7e\x7e\x70\xf7\xfd\x7e\x25\x79\xa6\xab\x23\xf5\x24\x70\xf6\xa7\x55\x95\x1f\x4b\xe0\x21\xa6\xbf\xb1\xcc\xfb\x0d\x05\x1d\x4e\xef\x7f\xa2\xac\x83\xf1\xfe\x25\xee\x7e\x23\x71\x77\xa6\xab\x8f\x2d\xf1\x1a\x02\x8f\x5c\x96\xe1\x10\xac\x56\x13\xf6\x63\x23\x18\xff\x2d\x95\xf2\x68\x24\x0b\x19\x36\x78\x22\x53\x82\x03\xdb\x8d\xed\x76\x26\x37\xc4\x2f\x75\x78\x72\xe2\x8f\xe7\x8e\x95\x8c\xe6\x8f\x3e\x5e\xfa\x11\x8e\x1c\xbc\xe6\x03\x91\x11\xde\xa9\xb0\x0f\x11\x52\x90\xdf\xae\x13\x89\x12\x67\xb5\xd3\xe3\xc7\xa6\xfc\x67\x7b\x08\xef\x31\xcd\x6a\xcf\x45\xa3\xce\xe0\x9d\x9b\x96\xd4\x0d\xa7\xea\x51\xaa\xbe\x9b\x14\xb7\x6e\x5d\xd9\x7b\x74\xf6\xc6\xd5\x2d\xbe\x31\xde\x0d\x56\x58\x31\xda\x75\x72\x29\xe4\x89\xd7\xc3\xda\x9f\xf2\x27\xf1\xfa\x4c\x19\x21\xb6\xa8\xe4\x2a\x87\xb2\xe8\x90\x8e\x9d\xa7\xd7\xf6\x2b\xfb\x19\x64\x64\xcf\xf3\x52\x40\x64\x09\x38\x16\x1c\x5f\x52\x3c\xb0\x44\x50\xa2\xcc\xc6\xad\x79\x01\xf9\x60\x19\xa6\x06\xc1\xc7\x51\x5c\x02\x6d\x3f\xee\x34\xbc\x57\x39\xce\x7e\x13\xd8\x14\x42\xe6\x85\x92\x54\xc4\x14\x46\x00\xf8\x95\x29\x20\x02\x01\x1c\xf0\x2e\xf1\x93\xfe\xae\x04\x38\xce\x0e\x9c\xe6\xb2\xbe\x9f\xeb\xf6\x19\x7d\xa4\x33\x19\x0e\x56\x3d\xa1\x3e\xbf\xa6\x46\xb4\x8e\x2f\x1d\x25\xe5\x35\xdc\x28\xc0\xd0\xf0\xba\x69\x7e\x04\x8e\x19\xbc\x42\x35\xe0\x9c\x2f\xea\x74\xe6\xa4\xce\x4e\xa6\xe5\x0f\xc4\x66\x3e\xb8\x66\x24\x91\x37\x14\xe6\xf1\x2e\x9e\xde\x36\xe7\x16\x67\x73\xa0\x38\xa2\x09\x35\x94\xd2\xce\x6c\x40\xdf\x3d\xf0\xcb\xbc\x40\x82\xbf\x82\x14\x4e\xc8\x1f\x56\x02\xd2\xfd\xb5\x5b\x67\xb8\x48\x55\xc1\xcd\x6a\x28\xde\x56\x8a\x54\x28\xc5\xe1\x0b\x18\xd2\x7c\x1e\xc5\x92\x0d\x08\xe0\x28\x91\x4f\xd9\x8d\x60\x99\x2c\x3f\xd7\xac\x14\x70\x69\x5f\x26\x47\xcc\xa4\x7d\xf1\x0d\x66\xb6\xe7\x8b\x05\x0d\x39\xe1\x6d\x65\xf4\x0b\xf2\x72\xb1\xd4\xb4\x99\x07\x44\x04\xe0\x4b\x76\xdf\xfb\x42\x69\x13\x89\x68\x34\xfa\xc0\x5a\xe6\x50\x59\x76\xc3\x2e\xee\xa8\xcb\x91\xa9\xc1\x39\x26\x0a\xa8\xe4\x3f\x65\xde\xa9\x88\x0d\xc3\x28\xb8\x0e\x04\xa9\x7f\x24\xe7\x40\xa8\x7f\x4c\x94\x08\x11\x0b\xde\xde\xc3\x24\x11\x03\xa7\x50\xee\x33\x1f\xe0\xab\x2e\x95\x0e\xf1\xb9\x4b\x4e\xde\xc7\xdc\xe6\x53\xe0\x0b\x5e\xe6\xa9\x02\xe8\x84\x17\x62\x45\x8c\xdf\x03\xbf\xc9\xfc\xcd\x77\x54\xab\xd8\xe9\xfe\xbe\xee\x6d\x1b\x02\xfa\x0d\x10\x19\x88\xbb\x34\x2c\xba\x15\x2f\x82\x5f\x88\xad\x94\x80\xfa\xbc\x78\x5a\x41\x04\x09\x8c\xf6\xaa\x7a\x42\x4d\x3e\x80\x2a\x36\x65\x33\x13\xde\x27\xab\xdb\xd4\xd9\x37\x98\x4f\x22\x2c\xc9\xdb\x1a\x26\x44\x36\x7b\xd6\x7e\x88\x72\x9d\x52\x47\x1e\x9d\x3c\xb2\x65\x62\x1a\x22\x9b\x39\xf6\xee\x53\x1b\xaf\x75\x15\xc5\xed\x60\xaf\xa7\xf8\xee\xae\x03\x30\xe7\xbc\xba\x16\xf6\x34\xfd\xdc\x5e\x95\x32\x45\x1f\x30\xa0\xca\x15\x9b\x49\x9c\x3d\x24\xa5\x5a\xdd\x92\xc3\xa5\x3e\x81\x9f\x11\x85\x4b\x53\x72\x89\x91\x71\xfb\x29\x4b\x4d\x05\x44\xd8\x4c\x94\xa4\xf3\xc0\x6e\x35\xda\x08\xa4\x12\x7d\x28\x43\xf3\x32\xe3\x55\xc6\x8a\x7c\x52\xf1\x6a\x43\x05\xb5\x6b\xf5\x0e\xc8\xb7\x14\xf9\x70\xf0\x9d\x04\x44\xe0\x6e\x55\xf7\xec\xc7\x7e\xbb\xc6\xb4\x81\x04\x98\xeb\x4e\x19\x51\xcc\x92\xa8\x11\x83\xee\xe3\xda\xd2\x00\xd6\xf2\xe6\x4b\xec\xd2\x52\x12\x31\x0d\x00\xc5\x51\x83\x24\xed\xba\x6a\xff\xb3\x2b\x85\x1e\xaa\xcd\x40\xc6\x05\x74\x8e\x83\xd2\xa5\xe5\xa2\x1c\x51\xa3\xa1\x56\x4a\x68\xe6\x28\x6b\x5d\x36\x5e\xf5\x5c\xf8\x3f\x5c\x29\x60\xc3\x7b\x5e\x04\x8a\x4a\x52\x61\xce\x7f\x15\x22\xfd\x57\x21\x52\xbf\x10\x29\x1d\x44\xfc\x21\xb3\x72\x7a\x17\xa9\x3e\x6f\xd9\x7b\x5a\x74\x6c\x86\x0c\xc8\x6b\x4b\x3b\xa0\xdb\x47\xce\x89\xb9\x75\x2a\xcc\x31\xc9\xc9\x1f\x2f\x0d\xa5\x99\x79\xfc\x9b\x24\xde\x7c\xe4\x44\x91\x8f\x10\x6a\xfd\xe0\x73\x25\xc2\xbb\x16\x4e\xe3\x9e\x4d\xf6\xaf\x2c\x83\xff\x6b\xb2\x0c\xbc\xa5\xab\x03\x77\x2e\x3e\xd4\x77\xbf\x0d\xfe\x45\xd9\x42\x63\x79\xa1\x05\xef\x8e\x5e\xe7\x8a\x1b\xb1\xc7\x9c\xaf\x0b\xa7\x9e\x9b\x80\x5f\xf0\x35\xfc\xf1\x1c\xca\x59\x50\xac\x45\x94\x33\x7d\x05\x9f\x1a\x01\x13\x4b\xd9\x20\x0f\x7c\x7c\x4d\x28\x6d\xe7\xda\x76\x4c\x48\x18\x5a\xcf\x04\x23\xd2\x67\xa4\xab\x4d\xf4\xb0\x77\x5c\x9c\xd6\x9c\xaf\xc1\x27\x01\x34\xbb\xf3\x6a\x04\x3f\xeb\x63\xfa\xf5\xca\x06\xe0\x42\xd3\xa2\x55\xa4\x49\xc1\xd1\x9b\x02\xbb\x2f\x13\x55\xb1\xf1\xbe\x3f\xdf\xfe\xe8\xc2\x98\x89\x64\x96\x80\x47\xaa\xf2\x9f\x05\x7c\xf1\x97\x57\x15\x87\xef\x38\x65\x62\x6d\x3e\xa4\x41\xa7\x2f\x3d\xd3\xf2\xa2\x40\x0e\x45\x77\xa9\xdd\x9f\x86\x4d\x36\x81\x79\x2b\x96\xac\x44\x35\x91\x4a\xa0\x1d\x00\xd7\x37\x03\x1a\xd3\x56\xdb\xda\x6e\x4b\x3e\x77\x2c\x50\x83\xbd\xef\x89\x04\x03\x35\x44\x1b\xf8\xd7\x7e\x22\xce\xff\x16\xed\x42\x2a\x95\xc3\x5d\x2e\x5a\x62\x8a\xdc\x07\x3e\x4b\x61\x63\x75\x70\x85\x2b\x57\x6c\xb2\xcc\x0b\xcd\x64\x99\x52\x4a\xaa\xe8\xfd\x8a\x29\x7e\xf8\xef\xe0\xb7\x4c\xdb\xb8\xe2\xb7\xaf\x08\xa9\xd6\x77\x4c\xed\xf3\xe0\x37\xc2\xe0\x5f\xd5\xfd\x86\x69\xa7\x45\xe7\x4b\xa6\x3e\x31\x03\xbb\x95\xac\xed\xc6\x22\x12\xb1\x12\x51\x6a\xa8\xa3\xc5\x75\x8f\xd1\x43\xe1\xf1\x3f\x6a\x01\x2d\x1a\xa0\xcb\x1c\x2e\x98\xfa\x3b\x33\x84\x41\xb0\xe7\x6b\x68\x4d\xce\x68\xbe\x0f\x72\x88\x81\xb6\x97\x45\xa8\x49\x87\x47\x1c\x5f\xc0\x42\x34\x97\xdd\x1a\x08\xaa\xad\x6f\x44\xa9\x67\x32\xc9\xe5\x89\x28\xf5\x89\x4a\xaf\xc4\x9c\x9f\x60\x39\x18\x06\xae\xb6\xed\xd3\xd6\x3b\x41\xb3\xa1\xbd\x29\xcc\x76\xef\x6e\x8b\x07\x07\xe6\x7d\xb0\xa8\x18\x61\x05\xe6\x6d\xc9\xe7\x7e\x39\x30\x8a\xc4\x7b\xae\x93\x1f\x16\xc3\xb7\x87\xf4\x1e\xf8\x9e\x6e\x1b\x25\xeb\x79\x2b\xa2\xf0\x8f\x17\x1d\xcf\x0b\xda\x04\x5c\x77\xab\x14\x10\xf1\x7f\xbc\x78\x4e\xf7\xd8\x2d\x63\x0a\xb3\x0a\xc0\x63\xbc\xb8\xe1\x1b\x93\x07\x5a\xfb\x48\xd4\x03\xb8\xa4\x12\x33\x5e\x65\x85\x50\x4e\xf3\x99\x15\x92\xe4\x76\x40\xc7\xc4\x9e\xf8\xec\x8b\x55\xd5\x73\x88\x04\xbb\xb7\x9e\x17\xc9\xd3\x12\x42\x44\x50\x7b\x56\xc3\xe9\x09\x3c\x3a\x83\xbf\x9e\x1a\xec\x3c\xeb\xaa\x6f\x3a\x03\x05\xed\x13\xb2\x06\x00\x00\xfc\xb9\x7d\x2e\x53\x5e\x3c\x64\xa3\xce\x74\x46\xf5\x81\x17\xf3\xc2\xc0\x82\x50\xa1\x81\x3d\xef\x97\x70\xeb\xf8\xc0\x3d\x2b\x71\xcb\x30\xca\x3f\x5e\x3c\x8f\x32\x43\x93\x27\xe2\x58\x9a\xec\xa9\xa7\x99\x11\x18\x3b\x1f\xac\xa6\x39\x66\x77\xcd\x5c\xdc\xa1\xae\xf5\x31\x5a\x8e\xec\x27\x76\x88\x9b\xfc\xfc\x48\xeb\x2a\x44\x49\xae\x75\x95\x4f\x96\x5a\xb0\x3d\x14\xed\x67\x31\x00\x8b\x9e\xbb\x63\x0a\x48\x43\x99\x17\x09\xbc\x08\xb9\x14\xf4\x6a\x0b\xa0\x1e\xd2\xe9\x02\x7d\x79\xa2\xe6\x86\xc6\xd7\x00\xfc\xd5\x3b\x3c\x8b\xdb\x73\x06\xc0\x8e\x00\x90\x43\xd2\x63\x82\x43\x6b\x05\xfd\x8c\x96\xfc\x08\x21\x8c\xa0\xc8\x6a\xc4\xd3\x6a\xd9\xe5\x3f\x26\xc1\xf3\x08\xcb\x6c\xf6\x86\x20\x5d\xeb\x80\xa3\xd1\xa6\x4f\x0d\xca\x4b\xd9\xe8\x8f\x8e\x92\x80\x9e\xf8\xc2\x39\x49\x92\x78\x7c\xcc\x04\x37\xbc\x21\x94\xe1\x27\x4d\x88\xd8\xed\xbf\x1e\x75\x85\x01\xb6\xda\xb3\xe2\x3d\x9c\x0b\xa0\xa2\x83\x55\x62\xea\x49\x04\xf9\xb1\x17\x9f\x5b\xf2\x20\xc0\x8b\x5c\x5f\x74\x3e\x7d\x04\x89\x1b\x8f\x93\x4c\x0e\x4c\xf4\x87\xaa\xf0\x1b\x5c\x78\x2d\x1b\x0b\xaf\x65\x7b\xe1\xcf\x5f\x75\x09\x8d\xad\xf6\x90\xb9\x67\xe1\x01\xd4\x31\x01\xfe\xfe\x28\x6d\x90\x15\x7a\x31\x5c\x96\x7b\x70\xec\x67\x05\x80\xf7\x91\x03\xb5\x14\x78\x72\x08\xf5\x44\x9f\xc2\x5f\xe7\x59\xc5\xbf\x3b\x97\xf8\xe7\xdf\xe0\x6f\x9e\x9c\xb0\xbf\x42\x6e\x17\xf9\xad\xf0\xb5\x09\x2c\x08\x82\xb9\x18\x50\x96\xad\x9c\xb1\x4c\xa6\x4b\x58\x10\xb0\x43\x14\x5b\x2e\xec\x47\x91\xf1\x18\x7d\xec\x8e\xd7\xd4\xa2\xc8\x35\x1e\xaf\xf1\xba\x9e\xa6\x39\xda\xaf\xa0\x6c\x1d\x6c\x8a\x8b\x4b\xf8\x33\xa2\xcd\x04\xa6\x2d\xfc\x56\x50\xaa\xfc\xeb\xaf\x9c\x95\xea\x4a\x37\x9a\xb7\x17\x0f\xbf\xfe\xea\x12\x6a\x5b\x8e\x92\x24\x19\xc1\xd4\xb7\xdb\xfb\x4c\x94\xd9\x6e\x37\xfc\x3f\x03\x00\x61\x6e\x78\x51\x73\xd6\x00\x00")
 
 func enumTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -85,7 +85,7 @@ func enumTmpl() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "enum.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfa, 0x4f, 0xe4, 0xcd, 0x99, 0xc, 0x30, 0x87, 0x37, 0xd9, 0xe9, 0x1f, 0x3d, 0xf9, 0xb6, 0xc9, 0x71, 0x22, 0xdd, 0x20, 0xd9, 0x4c, 0x11, 0x45, 0x79, 0xeb, 0xe7, 0xb1, 0x73, 0x2f, 0xb, 0x36}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x85, 0x19, 0x34, 0x19, 0x7f, 0x7f, 0x30, 0xf4, 0xff, 0x19, 0xdb, 0xf9, 0x76, 0x48, 0x38, 0xcb, 0x75, 0x2, 0xa5, 0x5, 0x6b, 0x31, 0xb9, 0xf4, 0x48, 0xf, 0x14, 0x7a, 0x11, 0x73, 0x1b, 0x3c}}
 	return a, nil
 }
 
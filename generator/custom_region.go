@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+// CustomRegionMarker marks the start of the hand written code at the end of a generated file, which is kept when
+// regenerating the file with WithPreserveCustomRegion.
+const CustomRegionMarker = `// go-enum:custom`
+
+// MergeCustomRegion appends the custom region of the existing outputFile, starting at the CustomRegionMarker line,
+// to the generated code, along with the imports it needs.  The generated code is returned as is when preserving
+// the custom region is not enabled, or when the existing file doesn't exist or has no marker.
+func (g *Generator) MergeCustomRegion(outputFile string, generated []byte) ([]byte, error) {
+	if !g.preserveCustomRegion {
+		return generated, nil
+	}
+	existing, err := ioutil.ReadFile(outputFile)
+	if os.IsNotExist(err) {
+		return generated, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("generate: error reading existing file '%s': %s", outputFile, err)
+	}
+	region := customRegion(existing)
+	if region == nil {
+		return generated, nil
+	}
+
+	fset := token.NewFileSet()
+	existingFile, err := parser.ParseFile(fset, outputFile, existing, parser.ImportsOnly)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error parsing existing file '%s': %s", outputFile, err)
+	}
+
+	merged := append(append(append([]byte{}, bytes.TrimRight(generated, "\n")...), "\n\n"...), region...)
+	mergedFile, err := parser.ParseFile(fset, outputFile, merged, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error merging the custom region of '%s': %s", outputFile, err)
+	}
+	// Keep the imports of the existing file, goimports drops the ones that turn out to be unused.
+	for _, spec := range existingFile.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, fmt.Errorf("generate: error parsing existing file '%s': %s", outputFile, err)
+		}
+		name := ""
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		astutil.AddNamedImport(fset, mergedFile, name, path)
+	}
+
+	var buf bytes.Buffer
+	if err = printer.Fprint(&buf, fset, mergedFile); err != nil {
+		return nil, err
+	}
+	formatted, err := imports.Process(outputFile, buf.Bytes(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error formatting the custom region of '%s': %s", outputFile, err)
+	}
+	return formatted, nil
+}
+
+// customRegion returns the content of a file from the line holding the CustomRegionMarker, or nil without one.
+func customRegion(content []byte) []byte {
+	for start := 0; start < len(content); {
+		end := bytes.IndexByte(content[start:], '\n')
+		if end < 0 {
+			end = len(content) - start
+		}
+		if bytes.Equal(bytes.TrimSpace(content[start:start+end]), []byte(CustomRegionMarker)) {
+			return content[start:]
+		}
+		start += end + 1
+	}
+	return nil
+}
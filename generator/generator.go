@@ -2,15 +2,23 @@ package generator
 
 import (
 	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/printer"
 	"go/token"
+	"math/bits"
+	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"text/template"
+	"text/template/parse"
 	"unicode"
 
 	"github.com/Masterminds/sprig"
@@ -19,46 +27,184 @@ import (
 )
 
 const (
-	skipHolder         = `_`
-	parseCommentPrefix = `//`
+	skipHolder           = `_`
+	parseCommentPrefix   = `//`
+	defaultNumericPrefix = `X`
+	weightDirective      = `weight`
+	defaultWeight        = 1
+	hexDirective         = `hex`
+	httpStatusDirective  = `httpStatus`
+	categoryDirective    = `category`
+	nextDirective        = `next`
+	aliasDirectivePrefix = `alias:`
+	canonicalMarker      = `canonical`
+	deprecatedPrefix     = `Deprecated:`
+	formatsDirective     = `formats=`
+	stepDirective        = `step=`
+	xmlNameDirective     = `xmlName=`
+	csvResourcePrefix    = `@csv:`
+	stringEnumType       = `string`
 )
 
 var (
+	// enumFormats maps the formats of a formats directive to the template data key generating them.
+	// The text marshaller is used by json and yaml encoders alike.
+	enumFormats = map[string]string{
+		"json": "marshal",
+		"text": "marshal",
+		"yaml": "marshal",
+		"sql":  "sql",
+		"flag": "flag",
+		"toml": "toml",
+	}
+	formatDataKeys = []string{"marshal", "sql", "flag", "yaml", "toml"}
+	// stringEnumDataKeys are the template data keys the string enum template supports, or that don't enable an option.
+	stringEnumDataKeys = map[string]bool{
+		"enum":              true,
+		"name":              true,
+		"lowercase":         true,
+		"nocase":            true,
+		"forcelower":        true,
+		"lenient":           true,
+		"httpstatusdefault": true,
+		"marshal":           true,
+		"sql":               true,
+		"flag":              true,
+		"names":             true,
+		"ptr":               true,
+		"mustparse":         true,
+		"marker":            true,
+		"gqlgen":            true,
+		"parseordefault":    true,
+		"validate":          true,
+		"maxlen":            true,
+		"values":            true,
+		"entcompat":         true,
+		"textappender":      true,
+		"xml":               true,
+		"yaml":              true,
+		"toml":              true,
+	}
+)
+
+var (
+	// replacementNames holds the aliases added through ParseAliases, which every new Generator starts with.
+	//
+	// Deprecated: aliases are held per Generator, use Generator.WithAliases instead.
 	replacementNames = map[string]string{}
 )
 
 // Generator is responsible for generating validation files for the given in a go source file.
 type Generator struct {
-	Version           string
-	Revision          string
-	BuildDate         string
-	BuiltBy           string
-	t                 *template.Template
-	knownTemplates    map[string]*template.Template
-	userTemplateNames []string
-	fileSet           *token.FileSet
-	noPrefix          bool
-	lowercaseLookup   bool
-	caseInsensitive   bool
-	marshal           bool
-	sql               bool
-	flag              bool
-	names             bool
-	leaveSnakeCase    bool
-	prefix            string
-	sqlNullInt        bool
-	sqlNullStr        bool
-	ptr               bool
-	mustParse         bool
-	forceLower        bool
+	Version              string
+	Revision             string
+	BuildDate            string
+	BuiltBy              string
+	t                    *template.Template
+	funcs                template.FuncMap
+	knownTemplates       map[string]*template.Template
+	userTemplateNames    []string
+	userDefinedTemplates []string
+	templateDir          string
+	relativeTemplates    []string
+	replacementNames     map[string]string
+	fileSet              *token.FileSet
+	noPrefix             bool
+	lowercaseLookup      bool
+	caseInsensitive      bool
+	marshal              bool
+	sql                  bool
+	flag                 bool
+	names                bool
+	leaveSnakeCase       bool
+	prefix               string
+	sqlNullInt           bool
+	sqlNullStr           bool
+	ptr                  bool
+	mustParse            bool
+	forceLower           bool
+	protoInterop         bool
+	strictNames          bool
+	ordinal              bool
+	textAppender         bool
+	numericPrefix        string
+	mapstructure         bool
+	complete             bool
+	expvar               bool
+	csvHelpers           bool
+	zeroHelpers          bool
+	prefixedStrings      bool
+	emptyAs              string
+	sortedParse          bool
+	entCompat            bool
+	queryParam           bool
+	weights              bool
+	translatable         bool
+	rawParse             bool
+	sortable             bool
+	hexColor             bool
+	numericPassthrough   bool
+	requireContiguous    bool
+	contiguousSkips      bool
+	sqlDDL               bool
+	goStringer           bool
+	verboseErrors        bool
+	seq                  bool
+	metricLabels         bool
+	deprecationHook      bool
+	xmlMarshal           bool
+	csvResourceHeader    bool
+	categories           bool
+	lazyReverseMap       bool
+	markerInterface      bool
+	methods              map[string]bool
+	httpStatus           bool
+	httpStatusDefault    int
+	stringTemplate       string
+	ordinalConsts        bool
+	typeCheck            bool
+	preserveCustomRegion bool
+	definitions          bool
+	yaml                 bool
+	maxLen               bool
+	pattern              bool
+	toml                 bool
+	bson                 bool
+	jsonZeroRepr         string
+	sqlDual              string
+	byteCodec            bool
+	validatedWrapper     bool
+	values               bool
+	pgx                  bool
+	validate             bool
+	bitflag              bool
+	systemAliases        bool
+	walk                 bool
+	descriptions         bool
+	maxValue             bool
+	typedMap             bool
+	shortCode            bool
+	lenientParse         bool
+	fuzzyParse           bool
+	binary               bool
+	transitions          bool
+	gqlgen               bool
+	parseOrDefault       bool
+	navigation           bool
+	navigationClamp      bool
+	sourceOrder          bool
 }
 
 // Enum holds data for a discovered enum in the parsed source
 type Enum struct {
-	Name   string
-	Prefix string
-	Type   string
-	Values []EnumValue
+	Name      string
+	Prefix    string
+	Type      string
+	ProtoType string
+	Formats   []string
+	XMLName   string
+	Doc       []string
+	Values    []EnumValue
 }
 
 // EnumValue holds the individual data for each enum value within the found enum.
@@ -68,6 +214,17 @@ type EnumValue struct {
 	PrefixedName string
 	Value        interface{}
 	Comment      string
+	Weight       int
+	Hex          string
+	Canonical    bool
+	Deprecated   bool
+	Categories   []string
+	HTTPStatus   int
+	StringValue  string
+	// SystemAliases holds the extra names of the value, keyed by the system that uses them.
+	SystemAliases map[string][]string
+	// Next holds the names of the values this value can transition to.
+	Next []string
 }
 
 // NewGenerator is a constructor method for creating a new Generator with default
@@ -83,6 +240,12 @@ func NewGenerator() *Generator {
 		t:                 template.New("generator"),
 		fileSet:           token.NewFileSet(),
 		noPrefix:          false,
+		numericPrefix:     defaultNumericPrefix,
+		replacementNames:  make(map[string]string, len(replacementNames)),
+	}
+
+	for k, v := range replacementNames {
+		g.replacementNames[k] = v
 	}
 
 	funcs := sprig.TxtFuncMap()
@@ -92,7 +255,31 @@ func NewGenerator() *Generator {
 	funcs["unmapify"] = Unmapify
 	funcs["namify"] = Namify
 	funcs["offset"] = Offset
-
+	funcs["ordinalify"] = Ordinalify
+	funcs["sortify"] = Sortify
+	funcs["weightify"] = Weightify
+	funcs["canonicals"] = Canonicals
+	funcs["deprecations"] = Deprecations
+	funcs["categorify"] = Categorify
+	funcs["labelify"] = Labelify
+	funcs["unlabelify"] = Unlabelify
+	funcs["maxnamelen"] = MaxNameLen
+	funcs["patternify"] = Patternify
+	funcs["validify"] = Validify
+	funcs["categorysets"] = CategorySets
+	funcs["flagify"] = Flagify
+	funcs["systemaliasify"] = SystemAliasify
+	funcs["describify"] = Describify
+	funcs["description"] = Description
+	funcs["maxvalue"] = MaxValue
+	funcs["shortcodes"] = ShortCodes
+	funcs["fuzzify"] = Fuzzify
+	funcs["lowerify"] = Lowerify
+	funcs["binarybits"] = BinaryBits
+	funcs["unsigned"] = IsUnsigned
+	funcs["transitify"] = Transitify
+
+	g.funcs = funcs
 	g.t.Funcs(funcs)
 
 	g.addEmbeddedTemplates()
@@ -187,8 +374,475 @@ func (g *Generator) WithForceLower() *Generator {
 	return g
 }
 
+// WithProtoInterop is used to add conversion methods to and from the protobuf enum type named
+// in the `PROTO(pkg.Type)` comment directive.  Every enum must provide the directive when this is enabled.
+func (g *Generator) WithProtoInterop() *Generator {
+	g.protoInterop = true
+	return g
+}
+
+// WithStrictNames is used to fail generation when a value name would need sanitizing (beyond alias
+// replacement and snake_case to CamelCase conversion) in order to be a valid Go identifier.
+func (g *Generator) WithStrictNames() *Generator {
+	g.strictNames = true
+	return g
+}
+
+// WithOrdinal is used to add helpers converting between a value and its declaration order index.
+func (g *Generator) WithOrdinal() *Generator {
+	g.ordinal = true
+	return g
+}
+
+// WithTextAppender is used to add an AppendText method implementing encoding.TextAppender.
+func (g *Generator) WithTextAppender() *Generator {
+	g.textAppender = true
+	return g
+}
+
+// WithNumericPrefix is used to replace the default 'X' prefix added to value names that do not start with a letter.
+func (g *Generator) WithNumericPrefix(prefix string) *Generator {
+	g.numericPrefix = prefix
+	return g
+}
+
+// WithMapstructure is used to add a mapstructure (and therefore viper) compatible decode hook.
+func (g *Generator) WithMapstructure() *Generator {
+	g.mapstructure = true
+	return g
+}
+
+// WithComplete is used to add a completion helper listing every value whose name starts with a prefix.
+func (g *Generator) WithComplete() *Generator {
+	g.complete = true
+	return g
+}
+
+// WithExpvar is used to add a Var wrapper type that can be published with expvar.
+func (g *Generator) WithExpvar() *Generator {
+	g.expvar = true
+	return g
+}
+
+// WithAliases is used to add aliases to replace during name sanitization for this Generator only.
+func (g *Generator) WithAliases(aliases map[string]string) *Generator {
+	for k, v := range aliases {
+		g.replacementNames[k] = v
+	}
+	return g
+}
+
+// WithCSVHelpers is used to add helpers for writing and reading the enum as a CSV field.
+func (g *Generator) WithCSVHelpers() *Generator {
+	g.csvHelpers = true
+	return g
+}
+
+// WithZeroHelpers is used to add helpers for getting and checking the zero value of the enum.
+func (g *Generator) WithZeroHelpers() *Generator {
+	g.zeroHelpers = true
+	return g
+}
+
+// WithPrefixedStrings is used to make the string form of each value its prefixed constant name, e.g. `ColorRed` rather than `Red`.
+func (g *Generator) WithPrefixedStrings() *Generator {
+	g.prefixedStrings = true
+	return g
+}
+
+// WithEmptyAs is used to make parsing an empty string return the value with the given name instead of an error.
+// Enums without a value of that name are not affected, but generation fails when none of the enums has it, as the name is most likely misspelled.
+func (g *Generator) WithEmptyAs(name string) *Generator {
+	g.emptyAs = name
+	return g
+}
+
+// WithSortedParse is used to parse with a binary search over a sorted array of names instead of a map.
+func (g *Generator) WithSortedParse() *Generator {
+	g.sortedParse = true
+	return g
+}
+
+// WithEntCompat is used to add a Values() []string method matching ent's field.EnumValues interface.
+// As that method name is taken, the typed values are returned by a package level {{ENUM}}Values function instead.
+func (g *Generator) WithEntCompat() *Generator {
+	g.entCompat = true
+	return g
+}
+
+// WithQueryParam is used to add a QueryParam method and a matching parse for use with url.Values.
+func (g *Generator) WithQueryParam() *Generator {
+	g.queryParam = true
+	return g
+}
+
+// WithWeights is used to add a Weight method and a weighted random selector, using the weight=N value comments.
+func (g *Generator) WithWeights() *Generator {
+	g.weights = true
+	return g
+}
+
+// WithTranslatable is used to add a StringWith method that looks the name up in a provided translations map first.
+func (g *Generator) WithTranslatable() *Generator {
+	g.translatable = true
+	return g
+}
+
+// WithRawParse is used to add a token level parse entry point, and a raw parse that does an exact lookup
+// without any normalization or error formatting, for performance critical tokenizers.
+func (g *Generator) WithRawParse() *Generator {
+	g.rawParse = true
+	return g
+}
+
+// WithSortable is used to add a slice type implementing sort.Interface, ordering by the underlying value.
+func (g *Generator) WithSortable() *Generator {
+	g.sortable = true
+	return g
+}
+
+// WithHexColor is used to add a HexColor method, using the hex=#rrggbb value comments.
+func (g *Generator) WithHexColor() *Generator {
+	g.hexColor = true
+	return g
+}
+
+// WithNumericPassthrough is used to add JSON marshalling that keeps undefined values as their integer, so they survive a round-trip.
+func (g *Generator) WithNumericPassthrough() *Generator {
+	g.numericPassthrough = true
+	return g
+}
+
+// WithSQLDDL is used to add helpers returning a SQL CHECK constraint and a postgres CREATE TYPE statement for the enum values.
+func (g *Generator) WithSQLDDL() *Generator {
+	g.sqlDDL = true
+	return g
+}
+
+// WithGoStringer is used to add a GoString method returning the constant name, for readable %#v output.
+func (g *Generator) WithGoStringer() *Generator {
+	g.goStringer = true
+	return g
+}
+
+// WithVerboseErrors is used to make parse errors quote the (truncated) input, and list the valid names.
+func (g *Generator) WithVerboseErrors() *Generator {
+	g.verboseErrors = true
+	return g
+}
+
+// WithSeq is used to add iter.Seq and iter.Seq2 functions over the enum values, for range-over-func loops (go 1.23+).
+func (g *Generator) WithSeq() *Generator {
+	g.seq = true
+	return g
+}
+
+// WithRequireContiguous is used to fail generation when the enum values do not form a gapless 0..N-1 sequence.
+// When allowSkipped is set, values taken by `_` skip holders count as part of the sequence, otherwise they are gaps.
+func (g *Generator) WithRequireContiguous(allowSkipped bool) *Generator {
+	g.requireContiguous = true
+	g.contiguousSkips = allowSkipped
+	return g
+}
+
+// WithMetricLabels is used to add a LabelValue method returning a lowercase snake_case form for metric labels, and a matching parse.
+func (g *Generator) WithMetricLabels() *Generator {
+	g.metricLabels = true
+	return g
+}
+
+// WithDeprecationHook is used to add a package level hook variable that Parse calls when it parses a deprecated value.
+// Values are deprecated by starting their comment with `Deprecated:`, following the go convention.
+func (g *Generator) WithDeprecationHook() *Generator {
+	g.deprecationHook = true
+	return g
+}
+
+// WithXML is used to add xml marshalling methods for elements and attributes, using the xmlName= directive as element name when present.
+func (g *Generator) WithXML() *Generator {
+	g.xmlMarshal = true
+	return g
+}
+
+// WithXMLMarshal is used to add xml marshalling methods, using the xmlName= directive as element name when present.
+//
+// Deprecated: use WithXML instead.
+func (g *Generator) WithXMLMarshal() *Generator {
+	return g.WithXML()
+}
+
+// WithCSVResourceHeader is used to skip the first row of the CSV files read by ENUM(@csv:file.csv) declarations.
+func (g *Generator) WithCSVResourceHeader() *Generator {
+	g.csvResourceHeader = true
+	return g
+}
+
+// WithCategories is used to add an InCategory method, using the category=name[,name] value comments.
+func (g *Generator) WithCategories() *Generator {
+	g.categories = true
+	return g
+}
+
+// WithLazyReverseMap is used to build the name to value map used by parsing on first use, instead of at package initialization.
+func (g *Generator) WithLazyReverseMap() *Generator {
+	g.lazyReverseMap = true
+	return g
+}
+
+// WithMarkerInterface is used to add a GoEnum marker method, so the enum implements the goenum.Enum interface.
+// The method is exported, as interfaces with unexported methods can't be implemented outside of their package.
+func (g *Generator) WithMarkerInterface() *Generator {
+	g.markerInterface = true
+	return g
+}
+
+// WithHTTPStatus is used to add an HTTPStatus method, using the httpStatus=N value comments.
+// Values without a directive return fallback, or 500 (Internal Server Error) when fallback is 0.
+func (g *Generator) WithHTTPStatus(fallback int) *Generator {
+	g.httpStatus = true
+	g.httpStatusDefault = fallback
+	if fallback == 0 {
+		g.httpStatusDefault = http.StatusInternalServerError
+	}
+	return g
+}
+
+// WithStringTemplate is used to render the string form of each value with a text/template at generation time.
+// The template is executed against a StringTemplateData for each value, e.g. `{{.Name}} (#{{.Value}})`.
+// The rendered strings are used for parsing and marshalling as well, so they must be unique within the enum.
+func (g *Generator) WithStringTemplate(tmpl string) *Generator {
+	g.stringTemplate = tmpl
+	return g
+}
+
+// WithTypeCheck is used to type check the generated code, together with the file declaring the enums, before returning it.
+// This catches broken templates and unsupported base types at generation time, at the cost of type checking the imports.
+func (g *Generator) WithTypeCheck() *Generator {
+	g.typeCheck = true
+	return g
+}
+
+// WithPreserveCustomRegion is used to keep the hand written code following a CustomRegionMarker line in an existing
+// output file when regenerating it, see MergeCustomRegion.
+func (g *Generator) WithPreserveCustomRegion() *Generator {
+	g.preserveCustomRegion = true
+	return g
+}
+
+// WithMethods is used to only keep the generated functions and methods named, dropping the rest of the method set,
+// even when an enabled option would emit them. Functions are named without the enum name, so ParseColor is named Parse.
+// Methods the kept ones depend on, like String, have to be named as well.
+func (g *Generator) WithMethods(names ...string) *Generator {
+	g.methods = make(map[string]bool, len(names))
+	for _, name := range names {
+		g.methods[name] = true
+	}
+	return g
+}
+
+// WithOrdinalConstants is used to add an untyped constant holding the declaration order index of each value, e.g. ColorRedOrdinal.
+func (g *Generator) WithOrdinalConstants() *Generator {
+	g.ordinalConsts = true
+	return g
+}
+
+// WithDefinitions is used to add a Definitions function listing the name, value, description and deprecation of each value.
+func (g *Generator) WithDefinitions() *Generator {
+	g.definitions = true
+	return g
+}
+
+// WithYAML is used to add yaml marshalling methods, for yaml packages using the unmarshal function interface (like gopkg.in/yaml.v2).
+func (g *Generator) WithYAML() *Generator {
+	g.yaml = true
+	return g
+}
+
+// WithMaxLen is used to add a MaxNameLen constant holding the length of the longest string form of the enum values.
+func (g *Generator) WithMaxLen() *Generator {
+	g.maxLen = true
+	return g
+}
+
+// WithPattern is used to add a Pattern function returning a regular expression matching exactly the names of the enum.
+func (g *Generator) WithPattern() *Generator {
+	g.pattern = true
+	return g
+}
+
+// WithTOML is used to add toml marshalling methods, for toml packages using the Marshaler and Unmarshaler interfaces (like github.com/BurntSushi/toml).
+func (g *Generator) WithTOML() *Generator {
+	g.toml = true
+	return g
+}
+
+// WithBSON is used to add bson value marshalling methods for the MongoDB driver, storing the enum as a string.
+func (g *Generator) WithBSON() *Generator {
+	g.bson = true
+	return g
+}
+
+// WithSQLDual is used to add a Scan accepting both string columns holding the name and integer columns holding the value of the enum,
+// for databases storing the same enum either way. Value writes the enum as valueAs, which is either "string" or "int".
+func (g *Generator) WithSQLDual(valueAs string) *Generator {
+	g.sqlDual = valueAs
+	return g
+}
+
+// WithJSONZeroRepr is used to write the zero value of the enum as the given json (e.g. `""` or `null`) in MarshalJSON, and to read that json back as the zero value.
+func (g *Generator) WithJSONZeroRepr(repr string) *Generator {
+	g.jsonZeroRepr = repr
+	return g
+}
+
+// WithByteCodec is used to add a compact single byte encoding of the enum, using the declaration order index of the values rather than the values themselves.
+func (g *Generator) WithByteCodec() *Generator {
+	g.byteCodec = true
+	return g
+}
+
+// WithValidatedWrapper is used to add a wrapper type, e.g. ValidColor, that can only hold a defined value as NewValidColor is its only constructor.
+func (g *Generator) WithValidatedWrapper() *Generator {
+	g.validatedWrapper = true
+	return g
+}
+
+// WithValues is used to add a function returning all the defined values in declaration order, e.g. ColorValues.
+func (g *Generator) WithValues() *Generator {
+	g.values = true
+	return g
+}
+
+// WithPgx is used to add the TextValue and ScanText methods of the pgx v5 pgtype.TextValuer and pgtype.TextScanner interfaces, storing the enum as its string form.
+func (g *Generator) WithPgx() *Generator {
+	g.pgx = true
+	return g
+}
+
+// WithValidate is used to add an IsValid method, checking whether the enum is one of the defined values.
+func (g *Generator) WithValidate() *Generator {
+	g.validate = true
+	return g
+}
+
+// WithBitflag is used to generate bit flag enums, whose values default to powers of two and can be combined, with Has, Set and Clear methods.
+func (g *Generator) WithBitflag() *Generator {
+	g.bitflag = true
+	return g
+}
+
+// WithSystemAliases is used to add a parse function taking a system name, e.g. ParseColorFor, that also accepts the aliases the values
+// declare for that system with `alias:system=NAME` comment directives.
+func (g *Generator) WithSystemAliases() *Generator {
+	g.systemAliases = true
+	return g
+}
+
+// WithWalk is used to add a walk function, e.g. ColorWalk, visiting every declared slot of the enum, the skipped ones included.
+func (g *Generator) WithWalk() *Generator {
+	g.walk = true
+	return g
+}
+
+// WithDescriptions is used to add a Description method returning the comment of the value, or its string form when it has none.
+func (g *Generator) WithDescriptions() *Generator {
+	g.descriptions = true
+	return g
+}
+
+// WithMaxValue is used to add a MaxValue constant holding the largest underlying value of the enum, e.g. for sizing arrays indexed by value.
+func (g *Generator) WithMaxValue() *Generator {
+	g.maxValue = true
+	return g
+}
+
+// WithTypedMap is used to add a generic map type keyed by the enum, e.g. ColorMap[V], with a constructor adding an entry for every value.
+func (g *Generator) WithTypedMap() *Generator {
+	g.typedMap = true
+	return g
+}
+
+// WithShortCode is used to add short codes for the enum values, the URL-safe base64 encoding of their declaration order index.
+func (g *Generator) WithShortCode() *Generator {
+	g.shortCode = true
+	return g
+}
+
+// WithLenientParse is used to skip the enums whose declaration fails to parse, reporting them on stderr, instead of failing the generation.
+func (g *Generator) WithLenientParse() *Generator {
+	g.lenientParse = true
+	return g
+}
+
+// WithFuzzyParse is used to add a fuzzy parse function, e.g. ParseColorFuzzy, ignoring case, spaces, hyphens and underscores when matching names.
+func (g *Generator) WithFuzzyParse() *Generator {
+	g.fuzzyParse = true
+	return g
+}
+
+// WithBinary is used to add MarshalBinary and UnmarshalBinary methods, encoding the enum as its little-endian integer value.
+func (g *Generator) WithBinary() *Generator {
+	g.binary = true
+	return g
+}
+
+// WithTransitions is used to add an AllowedNext method, listing the values each value can transition to as declared with the next=name[,name] value comments.
+func (g *Generator) WithTransitions() *Generator {
+	g.transitions = true
+	return g
+}
+
+// WithGQLGen is used to add MarshalGQL and UnmarshalGQL methods, so the enum can be used as a gqlgen enum.
+func (g *Generator) WithGQLGen() *Generator {
+	g.gqlgen = true
+	return g
+}
+
+// WithParseOrDefault is used to add a parse function taking a default, e.g. ParseColorOrDefault, returning the default when parsing fails.
+func (g *Generator) WithParseOrDefault() *Generator {
+	g.parseOrDefault = true
+	return g
+}
+
+// WithNavigation is used to add Next and Prev methods stepping through the values in declaration order, wrapping around at the ends.
+func (g *Generator) WithNavigation() *Generator {
+	g.navigation = true
+	return g
+}
+
+// WithNavigationClamp is used to add the Next and Prev methods of WithNavigation, stopping at the ends instead of wrapping around.
+func (g *Generator) WithNavigationClamp() *Generator {
+	g.navigation = true
+	g.navigationClamp = true
+	return g
+}
+
+// WithSourceOrder is used to write the enums in the order they are declared in the source, instead of sorted by name.
+func (g *Generator) WithSourceOrder() *Generator {
+	g.sourceOrder = true
+	return g
+}
+
 // ParseAliases is used to add aliases to replace during name sanitization.
+// The aliases are picked up by every Generator created afterwards.
+//
+// Deprecated: this mutates package level state, use Generator.WithAliases instead.
 func ParseAliases(aliases []string) error {
+	aliasMap, err := ParseAliasEntries(aliases)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range aliasMap {
+		replacementNames[k] = v
+	}
+
+	return nil
+}
+
+// ParseAliasEntries parses alias entries in the "key:value,key2:value2" format into a map that can be given to
+// Generator.WithAliases, without touching any package level state.
+func ParseAliasEntries(aliases []string) (map[string]string, error) {
 	aliasMap := map[string]string{}
 
 	for _, str := range aliases {
@@ -196,230 +850,1196 @@ func ParseAliases(aliases []string) error {
 		for _, kvp := range kvps {
 			parts := strings.Split(kvp, ":")
 			if len(parts) != 2 {
-				return fmt.Errorf("invalid formatted alias entry %q, must be in the format \"key:value\"", kvp)
+				return nil, fmt.Errorf("invalid formatted alias entry %q, must be in the format \"key:value\"", kvp)
 			}
 			aliasMap[parts[0]] = parts[1]
 		}
 	}
 
-	for k, v := range aliasMap {
-		replacementNames[k] = v
-	}
-
-	return nil
+	return aliasMap, nil
 }
 
-// WithTemplates is used to provide the filenames of additional templates.
-func (g *Generator) WithTemplates(filenames ...string) *Generator {
-	for _, ut := range template.Must(g.t.ParseFiles(filenames...)).Templates() {
-		if _, ok := g.knownTemplates[ut.Name()]; !ok {
-			g.userTemplateNames = append(g.userTemplateNames, ut.Name())
-		}
-	}
-	g.updateTemplates()
-	sort.Strings(g.userTemplateNames)
+// WithTemplateDir is used to set the base directory that relative template filenames
+// given to WithTemplates are resolved against, instead of the directory of the file being generated.
+// It must be called before WithTemplates.
+func (g *Generator) WithTemplateDir(dir string) *Generator {
+	g.templateDir = dir
 	return g
 }
 
-// GenerateFromFile is responsible for orchestrating the Code generation.  It results in a byte array
-// that can be written to any file desired.  It has already had goimports run on the code before being returned.
-func (g *Generator) GenerateFromFile(inputFile string) ([]byte, error) {
-	f, err := g.parseFile(inputFile)
-	if err != nil {
-		return nil, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
+// WithTemplates is used to provide the filenames, or glob patterns, of additional templates.
+// Relative filenames are resolved against the directory set with WithTemplateDir or, if none was set,
+// against the directory of the file being generated, so a template can sit next to the source using it.
+// Those are only parsed once that file is known, when generating.
+// An error is returned if the templates fail to parse, or if any of them would replace one of the built in templates.
+func (g *Generator) WithTemplates(filenames ...string) error {
+	var resolved []string
+	for _, filename := range filenames {
+		if g.templateDir == "" && !filepath.IsAbs(filename) {
+			g.relativeTemplates = append(g.relativeTemplates, filename)
+			continue
+		}
+		resolved = append(resolved, g.resolveTemplatePath(g.templateDir, filename))
 	}
-	return g.Generate(f)
-
+	return g.parseTemplates(resolved)
 }
 
-// Generate does the heavy lifting for the code generation starting from the parsed AST file.
-func (g *Generator) Generate(f *ast.File) ([]byte, error) {
-	enums := g.inspect(f)
-	if len(enums) <= 0 {
-		return nil, nil
+// parseTemplates expands the glob patterns among the template filenames and adds the templates to the generator.
+func (g *Generator) parseTemplates(filenames []string) error {
+	if len(filenames) == 0 {
+		return nil
+	}
+	var resolved []string
+	for _, filename := range filenames {
+		if !strings.Contains(filename, "*") {
+			resolved = append(resolved, filename)
+			continue
+		}
+		matches, err := filepath.Glob(filename)
+		if err != nil {
+			return errors.WithMessagef(err, "failed matching user templates %q", filename)
+		}
+		if len(matches) == 0 {
+			return fmt.Errorf("no user templates match %q", filename)
+		}
+		resolved = append(resolved, matches...)
+	}
+
+	// Parse into a separate template first, parsing straight into g.t would silently replace built in templates.
+	userTemplates, err := template.New("").Funcs(g.funcs).ParseFiles(resolved...)
+	if err != nil {
+		return errors.WithMessage(err, "failed parsing user templates")
+	}
+	for _, ut := range userTemplates.Templates() {
+		if _, ok := g.knownTemplates[ut.Name()]; ok && !g.isUserTemplate(ut.Name()) {
+			return fmt.Errorf("user template %q collides with a built in template of the same name", ut.Name())
+		}
+	}
+
+	if _, err = g.t.ParseFiles(resolved...); err != nil {
+		return errors.WithMessage(err, "failed parsing user templates")
+	}
+	// Each file is executed for every enum, the templates defined within them only when a file uses them.
+	files := map[string]bool{}
+	for _, filename := range resolved {
+		files[filepath.Base(filename)] = true
+	}
+	for _, ut := range userTemplates.Templates() {
+		switch {
+		case g.isUserTemplate(ut.Name()):
+		case files[ut.Name()]:
+			g.userTemplateNames = append(g.userTemplateNames, ut.Name())
+		default:
+			g.userDefinedTemplates = append(g.userDefinedTemplates, ut.Name())
+		}
+	}
+	g.updateTemplates()
+	sort.Strings(g.userTemplateNames)
+	sort.Strings(g.userDefinedTemplates)
+	return nil
+}
+
+// isUserTemplate checks whether the template name was added through WithTemplates.
+func (g *Generator) isUserTemplate(name string) bool {
+	for _, names := range [][]string{g.userTemplateNames, g.userDefinedTemplates} {
+		for _, userTemplateName := range names {
+			if userTemplateName == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// checkUserTemplates makes sure every template defined in the user templates is used by one of the template files,
+// as a template nothing executes is most likely a typo in its name, and would otherwise silently be left out.
+func (g *Generator) checkUserTemplates() error {
+	executed := map[string]bool{}
+	for _, name := range g.userTemplateNames {
+		g.markExecuted(name, executed)
+	}
+	for _, name := range g.userDefinedTemplates {
+		if !executed[name] {
+			return fmt.Errorf("user template %q is never executed, none of the template files use it", name)
+		}
+	}
+	return nil
+}
+
+// markExecuted marks the template and every template it executes, directly or not, as executed.
+func (g *Generator) markExecuted(name string, executed map[string]bool) {
+	t := g.t.Lookup(name)
+	if executed[name] || t == nil || t.Tree == nil {
+		return
+	}
+	executed[name] = true
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.IfNode:
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			g.markExecuted(n.Name, executed)
+		}
+	}
+	walk(t.Tree.Root)
+}
+
+// resolveTemplatePath joins a relative template filename with the directory it is resolved against.
+func (g *Generator) resolveTemplatePath(dir, filename string) string {
+	if dir == "" || filepath.IsAbs(filename) {
+		return filename
+	}
+	return filepath.Join(dir, filename)
+}
+
+// prepareUserTemplates parses the templates given with a relative filename and no template directory,
+// from the directory of the file being generated, and checks that all the user templates get executed.
+// The relative templates are parsed again for every file, as each may have its own templates next to it.
+func (g *Generator) prepareUserTemplates(inputFile string) error {
+	if len(g.relativeTemplates) > 0 {
+		dir := filepath.Dir(inputFile)
+		filenames := make([]string, 0, len(g.relativeTemplates))
+		for _, filename := range g.relativeTemplates {
+			filenames = append(filenames, g.resolveTemplatePath(dir, filename))
+		}
+		if err := g.parseTemplates(filenames); err != nil {
+			return err
+		}
+	}
+	return g.checkUserTemplates()
+}
+
+// GenerateFromFile is responsible for orchestrating the Code generation.  It results in a byte array
+// that can be written to any file desired.  It has already had goimports run on the code before being returned.
+func (g *Generator) GenerateFromFile(inputFile string) ([]byte, error) {
+	f, err := g.parseFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
+	}
+	return g.Generate(f)
+
+}
+
+// Generate does the heavy lifting for the code generation starting from the parsed AST file.
+func (g *Generator) Generate(f *ast.File) ([]byte, error) {
+	enums := g.inspect(f)
+	if len(enums) <= 0 {
+		return nil, nil
+	}
+
+	if err := g.prepareUserTemplates(g.fileSet.Position(f.Package).Filename); err != nil {
+		return nil, err
 	}
 
 	pkg := f.Name.Name
 
 	vBuff := bytes.NewBuffer([]byte{})
+	if err := g.writeHeader(vBuff, pkg); err != nil {
+		return nil, err
+	}
+
+	// Make the output more consistent by iterating over sorted keys of map
+	var keys []string
+	for key := range enums {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if g.sourceOrder {
+		// The type specs know where they are declared, which is more reliable than the order inspect comes across them in.
+		sort.SliceStable(keys, func(i, j int) bool {
+			return enums[keys[i]].Pos() < enums[keys[j]].Pos()
+		})
+	}
+
+	var parseErrs []string
+	var generated []*Enum
+	for _, name := range keys {
+		ts := enums[name]
+
+		if hasTypeParams(ts) {
+			return nil, fmt.Errorf("generate: enum %q is a generic type, generic enum types are not supported", name)
+		}
+
+		// Parse the enum doc statement
+		enum, pErr := g.parseEnum(ts)
+		if pErr != nil {
+			if g.lenientParse {
+				fmt.Fprintf(os.Stderr, "Skipping enum %s: %s\n", name, pErr)
+			} else {
+				parseErrs = append(parseErrs, pErr.Error())
+			}
+			continue
+		}
+
+		if err := g.validateEnum(enum); err != nil {
+			return nil, err
+		}
+
+		if err := g.writeEnum(vBuff, name, enum); err != nil {
+			return vBuff.Bytes(), err
+		}
+		generated = append(generated, enum)
+	}
+
+	if len(parseErrs) > 0 {
+		return nil, fmt.Errorf("generate: failed parsing %d enum(s):\n\t%s", len(parseErrs), strings.Join(parseErrs, "\n\t"))
+	}
+	if err := g.validateEmptyAs(generated); err != nil {
+		return nil, err
+	}
+
+	formatted, err := formatOutput(pkg, vBuff)
+	if err != nil || !g.typeCheck {
+		return formatted, err
+	}
+	return formatted, g.checkTypes(f, formatted)
+}
+
+// GenerateFromJSONSchemaEnum generates a string enum called name in package pkg, with the values of an XSD or JSON schema enum.
+// As there is no source declaring the enum type, the output declares it as well.
+// The values are used as the string form of the enum verbatim, so they do not need to follow the ENUM(...) syntax.
+func (g *Generator) GenerateFromJSONSchemaEnum(pkg, name string, values []string) ([]byte, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("generate: schema enum %q has no values", name)
+	}
+
+	enum := &Enum{Name: name, Type: stringEnumType}
+	if !g.noPrefix {
+		enum.Prefix = name
+	}
+	if g.prefix != "" {
+		enum.Prefix = g.prefix + enum.Prefix
+	}
+	for _, value := range values {
+		valueName := strings.Title(value)
+		prefixedName := sanitizeValue(enum.Prefix+valueName, g.numericPrefix, g.replacementNames)
+		if !g.leaveSnakeCase {
+			prefixedName = snakeToCamelCase(prefixedName)
+		}
+		if g.forceLower {
+			value = strings.ToLower(value)
+		}
+		enum.Values = append(enum.Values, EnumValue{Name: valueName, RawName: value, PrefixedName: prefixedName, Value: value, Weight: defaultWeight})
+	}
+
+	if g.stringTemplate != "" {
+		if err := g.renderStrings(enum); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateUniqueNames(enum); err != nil {
+		return nil, err
+	}
+	if err := g.validateEnum(enum); err != nil {
+		return nil, err
+	}
+	if err := g.validateEmptyAs([]*Enum{enum}); err != nil {
+		return nil, err
+	}
+	if err := g.checkUserTemplates(); err != nil {
+		return nil, err
+	}
+
+	vBuff := bytes.NewBuffer([]byte{})
+	if err := g.writeHeader(vBuff, pkg); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(vBuff, "\n// %s is generated from a schema enum.\ntype %s string\n", name, name)
+	if err := g.writeEnum(vBuff, name, enum); err != nil {
+		return vBuff.Bytes(), err
+	}
+	return formatOutput(pkg, vBuff)
+}
+
+// validateEnum runs the checks a parsed enum has to pass, given the enabled options, before it is written.
+func (g *Generator) validateEnum(enum *Enum) error {
+	if g.strictNames {
+		if err := validateStrictNames(enum, g.replacementNames); err != nil {
+			return err
+		}
+	}
+
+	if g.protoInterop && enum.ProtoType == "" {
+		return fmt.Errorf("generate: enum %q is missing a PROTO(...) directive required for protobuf interop", enum.Name)
+	}
+
+	if err := validateFormats(enum); err != nil {
+		return err
+	}
+
+	if err := validateUniqueValues(enum); err != nil {
+		return err
+	}
+
+	if g.requireContiguous {
+		if err := validateContiguous(enum, g.contiguousSkips); err != nil {
+			return err
+		}
+	}
+
+	if g.bitflag {
+		if err := validateBitflag(enum, g.flag); err != nil {
+			return err
+		}
+	}
+
+	if g.sqlDual != "" {
+		if err := validateSQLDual(enum, g.sqlDual, g.sql || g.sqlNullInt || g.sqlNullStr); err != nil {
+			return err
+		}
+	}
+
+	if g.fuzzyParse {
+		if err := validateFuzzyNames(enum); err != nil {
+			return err
+		}
+	}
+
+	if g.binary && enum.Type == stringEnumType {
+		return fmt.Errorf("generate: enum %q is a string enum, which has no integer value to encode as binary", enum.Name)
+	}
+
+	if g.byteCodec {
+		if err := validateByteCodec(enum); err != nil {
+			return err
+		}
+	}
+
+	if enum.Type == stringEnumType {
+		data, err := g.templateData(enum.Name, enum)
+		if err != nil {
+			return err
+		}
+		if err := validateStringEnumOptions(enum, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeHeader executes the header template for the package into vBuff.
+func (g *Generator) writeHeader(vBuff *bytes.Buffer, pkg string) error {
 	err := g.t.ExecuteTemplate(vBuff, "header", map[string]interface{}{
 		"package":   pkg,
 		"version":   g.Version,
 		"revision":  g.Revision,
 		"buildDate": g.BuildDate,
 		"builtBy":   g.BuiltBy,
+		"bson":      g.bson,
+		"pgx":       g.pgx,
 	})
 	if err != nil {
-		return nil, errors.WithMessage(err, "Failed writing header")
+		return errors.WithMessage(err, "Failed writing header")
 	}
+	return nil
+}
 
-	// Make the output more consistent by iterating over sorted keys of map
-	var keys []string
-	for key := range enums {
-		keys = append(keys, key)
+// templateData returns the data the enum templates are executed with for the enum.
+func (g *Generator) templateData(name string, enum *Enum) (map[string]interface{}, error) {
+	data := map[string]interface{}{
+		"enum":               enum,
+		"name":               name,
+		"lowercase":          g.lowercaseLookup,
+		"nocase":             g.caseInsensitive,
+		"marshal":            g.marshal,
+		"sql":                g.sql,
+		"flag":               g.flag,
+		"names":              g.names,
+		"ptr":                g.ptr,
+		"sqlnullint":         g.sqlNullInt,
+		"sqlnullstr":         g.sqlNullStr,
+		"mustparse":          g.mustParse,
+		"forcelower":         g.forceLower,
+		"proto":              g.protoInterop,
+		"ordinal":            g.ordinal,
+		"textappender":       g.textAppender,
+		"mapstructure":       g.mapstructure,
+		"complete":           g.complete,
+		"expvar":             g.expvar,
+		"csv":                g.csvHelpers,
+		"zero":               g.zeroHelpers,
+		"sortedparse":        g.sortedParse,
+		"entcompat":          g.entCompat,
+		"queryparam":         g.queryParam,
+		"weights":            g.weights,
+		"translatable":       g.translatable,
+		"rawparse":           g.rawParse,
+		"sortable":           g.sortable,
+		"hexcolor":           g.hexColor,
+		"numericpassthrough": g.numericPassthrough,
+		"sqlddl":             g.sqlDDL,
+		"gostringer":         g.goStringer,
+		"verboseerrors":      g.verboseErrors,
+		"seq":                g.seq,
+		"metriclabels":       g.metricLabels,
+		"deprecationhook":    g.deprecationHook,
+		"xml":                g.xmlMarshal,
+		"categories":         g.categories,
+		"lazyreversemap":     g.lazyReverseMap,
+		"marker":             g.markerInterface,
+		"httpstatus":         g.httpStatus,
+		"httpstatusdefault":  g.httpStatusDefault,
+		"ordinalconsts":      g.ordinalConsts,
+		"definitions":        g.definitions,
+		"yaml":               g.yaml,
+		"maxlen":             g.maxLen,
+		"pattern":            g.pattern,
+		"toml":               g.toml,
+		"bson":               g.bson,
+		"bytecodec":          g.byteCodec,
+		"validatedwrapper":   g.validatedWrapper,
+		"values":             g.values,
+		"pgx":                g.pgx,
+		"sqldual":            g.sqlDual,
+		"validate":           g.validate,
+		"bitflag":            g.bitflag,
+		"systemaliases":      g.systemAliases,
+		"walk":               g.walk,
+		"descriptions":       g.descriptions,
+		"maxvalue":           g.maxValue,
+		"typedmap":           g.typedMap,
+		"shortcode":          g.shortCode,
+		"lenient":            g.lenientParse,
+		"fuzzyparse":         g.fuzzyParse,
+		"binary":             g.binary,
+		"transitions":        g.transitions,
+		"gqlgen":             g.gqlgen,
+		"parseordefault":     g.parseOrDefault,
+		"navigation":         g.navigation,
+		"navigationclamp":    g.navigationClamp,
+	}
+
+	if g.emptyAs != "" {
+		data["emptyas"] = emptyValueName(enum, g.emptyAs)
+	}
+
+	if g.jsonZeroRepr != "" {
+		var repr bytes.Buffer
+		if err := json.Compact(&repr, []byte(g.jsonZeroRepr)); err != nil {
+			return nil, fmt.Errorf("generate: the json zero value representation %q is not valid json: %s", g.jsonZeroRepr, err)
+		}
+		data["jsonzerorepr"] = repr.String()
+	}
+
+	// A formats directive on the enum replaces the globally enabled marshalling formats.
+	if enum.Formats != nil {
+		for _, key := range formatDataKeys {
+			data[key] = false
+		}
+		for _, format := range enum.Formats {
+			data[enumFormats[format]] = true
+			// The yaml format is served by the text marshaller, and by the yaml methods as well when enabled.
+			if format == "yaml" {
+				data["yaml"] = g.yaml
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// writeEnum executes the enum template, followed by the user templates, for the enum into vBuff.
+func (g *Generator) writeEnum(vBuff *bytes.Buffer, name string, enum *Enum) error {
+	data, err := g.templateData(name, enum)
+	if err != nil {
+		return err
+	}
+
+	enumBuff := bytes.NewBuffer([]byte{})
+	enumTemplate := "enum"
+	if enum.Type == stringEnumType {
+		enumTemplate = "enum_string"
+	}
+	err = g.t.ExecuteTemplate(enumBuff, enumTemplate, data)
+	if err != nil {
+		return errors.WithMessage(err, fmt.Sprintf("Failed writing enum data for enum: %q", name))
+	}
+	if g.methods != nil {
+		if err = g.filterMethods(enumBuff, name); err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("Failed filtering methods for enum: %q", name))
+		}
+	}
+	vBuff.Write(enumBuff.Bytes())
+
+	for _, userTemplateName := range g.userTemplateNames {
+		err = g.t.ExecuteTemplate(vBuff, userTemplateName, data)
+		if err != nil {
+			return errors.WithMessage(err, fmt.Sprintf("Failed writing enum data for enum: %q, template: %v", name, userTemplateName))
+		}
+	}
+	return nil
+}
+
+// filterMethods drops the functions and methods of the generated enum code in buff that are not in the methods allowlist.
+// Unexported helpers are always kept, as the kept functions may rely on them.
+func (g *Generator) filterMethods(buff *bytes.Buffer, name string) error {
+	const pkgClause = "package enum\n"
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", pkgClause+buff.String(), parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	var (
+		decls   []ast.Decl
+		removed []*ast.FuncDecl
+	)
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && !g.methodAllowed(fn, name) {
+			removed = append(removed, fn)
+			continue
+		}
+		decls = append(decls, decl)
+	}
+	if len(removed) == 0 {
+		return nil
+	}
+	f.Decls = decls
+
+	// Drop the comments of the removed functions, which the printer would otherwise keep.
+	comments := f.Comments[:0]
+	for _, group := range f.Comments {
+		keep := true
+		for _, fn := range removed {
+			if group == fn.Doc || group.Pos() >= fn.Pos() && group.End() <= fn.End() {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			comments = append(comments, group)
+		}
+	}
+	f.Comments = comments
+
+	filtered := bytes.NewBuffer([]byte{})
+	if err = printer.Fprint(filtered, fset, f); err != nil {
+		return err
+	}
+	buff.Reset()
+	buff.WriteString("\n")
+	buff.Write(bytes.TrimPrefix(filtered.Bytes(), []byte(pkgClause)))
+	return nil
+}
+
+// methodAllowed checks whether the generated function or method fn of the enum name is in the methods allowlist.
+func (g *Generator) methodAllowed(fn *ast.FuncDecl, name string) bool {
+	if !fn.Name.IsExported() {
+		return true
+	}
+	if g.methods[fn.Name.Name] {
+		return true
+	}
+	return fn.Recv == nil && g.methods[strings.Replace(fn.Name.Name, name, "", 1)]
+}
+
+// formatOutput runs the generated code through goimports, dropping the unused imports of the header.
+func formatOutput(pkg string, vBuff *bytes.Buffer) ([]byte, error) {
+	formatted, err := imports.Process(pkg, vBuff.Bytes(), nil)
+	if err != nil {
+		err = fmt.Errorf("generate: error formatting code %s\n\n%s", err, vBuff.String())
+	}
+	return formatted, err
+}
+
+// updateTemplates will update the lookup map for validation checks that are
+// allowed within the template engine.
+func (g *Generator) updateTemplates() {
+	for _, template := range g.t.Templates() {
+		g.knownTemplates[template.Name()] = template
+	}
+}
+
+// parseFile simply calls the go/parser ParseFile function with an empty token.FileSet
+func (g *Generator) parseFile(fileName string) (*ast.File, error) {
+	// Parse the file given in arguments
+	return parser.ParseFile(g.fileSet, fileName, nil, parser.ParseComments)
+}
+
+// parseEnum looks for the ENUM(x,y,z) formatted documentation from the type definition.
+// Errors are prefixed with the file:line of the type, so they can be found from a build log.
+func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
+	enum, err := g.parseEnumSpec(ts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", g.fileSet.Position(ts.Pos()), err)
+	}
+	return enum, nil
+}
+
+// parseEnumSpec does the parsing for parseEnum.
+func (g *Generator) parseEnumSpec(ts *ast.TypeSpec) (*Enum, error) {
+
+	if ts.Doc == nil {
+		return nil, errors.New("No Doc on Enum")
+	}
+
+	enum := &Enum{}
+
+	enum.Name = ts.Name.Name
+	enum.Type = fmt.Sprintf("%s", ts.Type)
+	if !g.noPrefix {
+		enum.Prefix = ts.Name.Name
+	}
+	if g.prefix != "" {
+		enum.Prefix = g.prefix + enum.Prefix
+	}
+
+	enumDecl, err := getEnumDeclFromComments(ts.Doc.List)
+	if err != nil {
+		return nil, err
+	}
+	enum.ProtoType = getProtoTypeFromComments(ts.Doc.List)
+	enum.Formats = getFormatsFromComments(ts.Doc.List)
+	enum.XMLName, _ = getDirectiveFromComments(ts.Doc.List, xmlNameDirective)
+	enum.Doc = getDocFromComments(ts.Doc.List)
+
+	step := uint64(1)
+	if stepVal, ok := getDirectiveFromComments(ts.Doc.List, stepDirective); ok {
+		newStep, err := strconv.ParseUint(stepVal, 10, 64)
+		if err != nil || newStep == 0 {
+			return nil, fmt.Errorf("failed parsing the step '%s' of enum %s, it must be a positive integer", stepVal, enum.Name)
+		}
+		step = newStep
+	}
+
+	values := strings.Split(strings.TrimSuffix(strings.TrimPrefix(enumDecl, `ENUM(`), `)`), `,`)
+	if len(values) == 1 && strings.HasPrefix(values[0], csvResourcePrefix) {
+		csvValues, err := g.readCSVResource(ts, strings.TrimPrefix(values[0], csvResourcePrefix))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed reading the values of enum %s", enum.Name)
+		}
+		values = csvValues
+	}
+	var (
+		data       interface{}
+		unsigned   bool
+		stringType = enum.Type == stringEnumType
+	)
+	if stringType {
+		data = ""
+	} else if IsUnsigned(enum.Type) {
+		data = uint64(0)
+		unsigned = true
+	} else {
+		data = int64(0)
+	}
+	if g.bitflag && !stringType {
+		data = nextFlag(data)
+	}
+	for _, value := range values {
+		var comment, stringValue string
+
+		// Trim and store comments
+		if strings.Contains(value, parseCommentPrefix) {
+			commentStartIndex := strings.Index(value, parseCommentPrefix)
+			comment = value[commentStartIndex+len(parseCommentPrefix):]
+			comment = strings.TrimSpace(unescapeComment(comment))
+			// value without comment
+			value = value[:commentStartIndex]
+		}
+
+		// Make sure to leave out any empty parts
+		if value != "" {
+			if strings.Contains(value, `=`) {
+				// Get the value specified and set the data to that value.
+				equalIndex := strings.Index(value, `=`)
+				dataVal := strings.TrimSpace(value[equalIndex+1:])
+				if dataVal != "" {
+					if stringType {
+						// String values can be quoted to hold characters the ENUM syntax uses.
+						if unquoted, err := strconv.Unquote(dataVal); err == nil {
+							dataVal = unquoted
+						}
+						data = dataVal
+					} else if str, ok := explicitStringValue(dataVal); ok {
+						stringValue = str
+					} else if unsigned {
+						// Base 0 accepts Go integer literals, like 0x10, 0o17, 0b101 and 1_000.
+						if strings.HasPrefix(dataVal, "-") {
+							return nil, fmt.Errorf("enum value '%s' is negative, which the unsigned type %s of enum %s can not hold", strings.TrimSpace(value), enum.Type, enum.Name)
+						}
+						newData, err := strconv.ParseUint(dataVal, 0, 64)
+						if err != nil {
+							return nil, errors.Wrapf(err, "failed parsing the data part of enum value '%s'", strings.TrimSpace(value))
+						}
+						data = newData
+					} else {
+						newData, err := strconv.ParseInt(dataVal, 0, 64)
+						if err != nil {
+							return nil, errors.Wrapf(err, "failed parsing the data part of enum value '%s'", strings.TrimSpace(value))
+						}
+						data = newData
+					}
+					value = value[:equalIndex]
+				} else {
+					value = strings.TrimSuffix(value, `=`)
+					fmt.Fprintf(os.Stderr, "Ignoring enum with '=' but no value after: %s\n", value)
+				}
+			}
+			rawName := strings.TrimSpace(value)
+			name := strings.Title(rawName)
+			prefixedName := name
+			if name != skipHolder {
+				prefixedName = enum.Prefix + name
+				prefixedName = sanitizeValue(prefixedName, g.numericPrefix, g.replacementNames)
+				if !g.leaveSnakeCase {
+					prefixedName = snakeToCamelCase(prefixedName)
+				}
+				if g.prefixedStrings && !g.noPrefix {
+					rawName = prefixedName
+				}
+				if stringValue != "" {
+					rawName = stringValue
+				}
+				// The value of a string enum is its string form, the name unless a value was given.
+				if stringType {
+					if data == "" {
+						data = rawName
+					}
+					if g.forceLower {
+						data = strings.ToLower(data.(string))
+					}
+					rawName = data.(string)
+				}
+			}
+
+			weight := defaultWeight
+			if g.weights && name != skipHolder {
+				var err error
+				if weight, err = getWeightFromComment(comment); err != nil {
+					return nil, errors.Wrapf(err, "failed parsing the weight of enum value '%s'", rawName)
+				}
+			}
+
+			var hex string
+			if g.hexColor && name != skipHolder {
+				var err error
+				if hex, err = getHexFromComment(comment); err != nil {
+					return nil, errors.Wrapf(err, "failed parsing the hex color of enum value '%s'", rawName)
+				}
+			}
+
+			var httpStatus int
+			if g.httpStatus && name != skipHolder {
+				var err error
+				if httpStatus, err = getHTTPStatusFromComment(comment); err != nil {
+					return nil, errors.Wrapf(err, "failed parsing the http status of enum value '%s'", rawName)
+				}
+			}
+
+			var categories []string
+			if g.categories && name != skipHolder {
+				if val, ok := getCommentDirective(comment, categoryDirective); ok && val != "" {
+					categories = strings.Split(val, `,`)
+				}
+			}
+
+			var systemAliases map[string][]string
+			if g.systemAliases && name != skipHolder {
+				systemAliases = getSystemAliasesFromComment(comment)
+			}
+
+			var next []string
+			if g.transitions && name != skipHolder {
+				if val, ok := getCommentDirective(comment, nextDirective); ok && val != "" {
+					next = strings.Split(val, `,`)
+				}
+			}
+
+			ev := EnumValue{Name: name, RawName: rawName, PrefixedName: prefixedName, Value: data, Comment: comment, Weight: weight, Hex: hex, Canonical: isCanonical(comment), Deprecated: strings.HasPrefix(comment, deprecatedPrefix), Categories: categories, HTTPStatus: httpStatus, StringValue: stringValue, SystemAliases: systemAliases, Next: next}
+			enum.Values = append(enum.Values, ev)
+			if stringType {
+				data = ""
+			} else if g.bitflag {
+				data = nextFlag(data)
+			} else {
+				data = increment(data, step)
+			}
+		}
+	}
+
+	if g.stringTemplate != "" {
+		if err := g.renderStrings(enum); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateCanonicals(enum); err != nil {
+		return nil, err
+	}
+
+	if err := validateUniqueNames(enum); err != nil {
+		return nil, err
+	}
+
+	if err := validateSystemAliases(enum); err != nil {
+		return nil, err
+	}
+
+	if err := validateTransitions(enum); err != nil {
+		return nil, err
+	}
+
+	// fmt.Printf("###\nENUM: %+v\n###\n", enum)
+
+	return enum, nil
+}
+
+// StringTemplateData holds the metadata of an enum value that a string template is executed against.
+type StringTemplateData struct {
+	Enum         string
+	Name         string
+	PrefixedName string
+	Value        interface{}
+	Comment      string
+	Categories   []string
+}
+
+// renderStrings replaces the string form of each enum value with the result of the string template.
+func (g *Generator) renderStrings(enum *Enum) error {
+	t, err := template.New("string").Funcs(sprig.TxtFuncMap()).Option("missingkey=error").Parse(g.stringTemplate)
+	if err != nil {
+		return errors.Wrap(err, "failed parsing the string template")
+	}
+	rendered := map[string]string{}
+	for i, val := range enum.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		var buf bytes.Buffer
+		err = t.Execute(&buf, StringTemplateData{
+			Enum:         enum.Name,
+			Name:         val.RawName,
+			PrefixedName: val.PrefixedName,
+			Value:        val.Value,
+			Comment:      val.Comment,
+			Categories:   val.Categories,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed rendering the string of enum value '%s'", val.RawName)
+		}
+		str := buf.String()
+		if str == "" {
+			return fmt.Errorf("the string template renders an empty string for enum value '%s'", val.RawName)
+		}
+		if other, ok := rendered[str]; ok {
+			return fmt.Errorf("the string template renders %q for both enum values '%s' and '%s'", str, other, val.RawName)
+		}
+		rendered[str] = val.RawName
+		enum.Values[i].RawName = str
+		if enum.Type == stringEnumType {
+			enum.Values[i].Value = str
+		}
+	}
+	return nil
+}
+
+// explicitStringValue checks whether the part after the `=` of an enum value declaration is a string, rather than a
+// number, and returns it unquoted.  Anything quoted, or not starting like a number, is a string.
+func explicitStringValue(dataVal string) (string, bool) {
+	if unquoted, err := strconv.Unquote(dataVal); err == nil {
+		return unquoted, true
+	}
+	if strings.IndexAny(dataVal[:1], "+-0123456789") >= 0 {
+		return "", false
+	}
+	return dataVal, true
+}
+
+// isCanonical checks whether a value comment marks the value as the canonical name among the names sharing its value.
+// The marker only counts among the directives leading the comment, so a description merely using the word does not mark the value.
+func isCanonical(comment string) bool {
+	comment = strings.TrimPrefix(strings.TrimSpace(comment), deprecatedPrefix)
+	for _, field := range strings.Fields(comment) {
+		if field == canonicalMarker {
+			return true
+		}
+		if !isCommentDirective(field) {
+			return false
+		}
+	}
+	return false
+}
+
+// descriptionFromComment returns a value comment without the directives go-enum reads from it,
+// so `weight=3 canonical Most used` describes the value as `Most used`.
+func descriptionFromComment(comment string) string {
+	comment = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(comment), deprecatedPrefix))
+	var words []string
+	for _, field := range strings.Fields(comment) {
+		if isCommentDirective(field) || (field == canonicalMarker && len(words) == 0) {
+			continue
+		}
+		words = append(words, field)
+	}
+	return strings.Join(words, " ")
+}
+
+// isCommentDirective checks whether a word of a value comment is one of the key=value or alias directives go-enum reads from it.
+func isCommentDirective(field string) bool {
+	if strings.HasPrefix(field, aliasDirectivePrefix) {
+		return true
+	}
+	for _, key := range []string{weightDirective, hexDirective, httpStatusDirective, categoryDirective, nextDirective} {
+		if strings.HasPrefix(field, key+`=`) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUniqueNames makes sure no two values of the enum end up with the same constant name once sanitized and camel cased,
+// e.g. `foo_bar` and `fooBar`.
+func validateUniqueNames(enum *Enum) error {
+	names := map[string]string{}
+	for _, val := range enum.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		if other, ok := names[val.PrefixedName]; ok {
+			return fmt.Errorf("enum %q value %q collides with %q, both are named %s", enum.Name, val.RawName, other, val.PrefixedName)
+		}
+		names[val.PrefixedName] = val.RawName
+	}
+	return nil
+}
+
+// validateSystemAliases makes sure no alias is used by two different values within the same system.
+func validateSystemAliases(enum *Enum) error {
+	owners := map[string]map[string]EnumValue{}
+	for _, val := range enum.Values {
+		for system, names := range val.SystemAliases {
+			if owners[system] == nil {
+				owners[system] = map[string]EnumValue{}
+			}
+			for _, name := range names {
+				if other, ok := owners[system][name]; ok && other.Value != val.Value {
+					return fmt.Errorf("enum %q uses the %s alias %q for both %q and %q", enum.Name, system, name, other.RawName, val.RawName)
+				}
+				owners[system][name] = val
+			}
+		}
+	}
+	return nil
+}
+
+// validateTransitions makes sure every value a value can transition to is a value of the enum.
+func validateTransitions(enum *Enum) error {
+	names := map[string]bool{}
+	for _, val := range enum.Values {
+		if val.Name != skipHolder {
+			names[val.RawName] = true
+		}
+	}
+	for _, val := range enum.Values {
+		for _, next := range val.Next {
+			if !names[next] {
+				return fmt.Errorf("enum %q value %q transitions to %q, which is not a value of the enum", enum.Name, val.RawName, next)
+			}
+		}
 	}
-	sort.Strings(keys)
-
-	for _, name := range keys {
-		ts := enums[name]
+	return nil
+}
 
-		// Parse the enum doc statement
-		enum, pErr := g.parseEnum(ts)
-		if pErr != nil {
+// validateCanonicals makes sure at most one of the names sharing a value is marked canonical.
+func validateCanonicals(enum *Enum) error {
+	canonicals := map[interface{}]string{}
+	for _, val := range enum.Values {
+		if val.Name == skipHolder || !val.Canonical {
 			continue
 		}
-
-		data := map[string]interface{}{
-			"enum":       enum,
-			"name":       name,
-			"lowercase":  g.lowercaseLookup,
-			"nocase":     g.caseInsensitive,
-			"marshal":    g.marshal,
-			"sql":        g.sql,
-			"flag":       g.flag,
-			"names":      g.names,
-			"ptr":        g.ptr,
-			"sqlnullint": g.sqlNullInt,
-			"sqlnullstr": g.sqlNullStr,
-			"mustparse":  g.mustParse,
-			"forcelower": g.forceLower,
+		if other, ok := canonicals[val.Value]; ok {
+			return fmt.Errorf("enum %q marks both %q and %q as canonical for value %v", enum.Name, other, val.RawName, val.Value)
 		}
+		canonicals[val.Value] = val.RawName
+	}
+	return nil
+}
 
-		err = g.t.ExecuteTemplate(vBuff, "enum", data)
-		if err != nil {
-			return vBuff.Bytes(), errors.WithMessage(err, fmt.Sprintf("Failed writing enum data for enum: %q", name))
+// getCommentDirective looks for a `key=value` directive in a value comment, and returns its value.
+func getCommentDirective(comment, key string) (string, bool) {
+	for _, field := range strings.Fields(comment) {
+		if strings.HasPrefix(field, key+`=`) {
+			return strings.TrimPrefix(field, key+`=`), true
 		}
+	}
+	return "", false
+}
 
-		for _, userTemplateName := range g.userTemplateNames {
-			err = g.t.ExecuteTemplate(vBuff, userTemplateName, data)
-			if err != nil {
-				return vBuff.Bytes(), errors.WithMessage(err, fmt.Sprintf("Failed writing enum data for enum: %q, template: %v", name, userTemplateName))
+// getSystemAliasesFromComment looks for `alias:system=NAME` directives in a value comment, and returns the names for each system.
+// Several names for the same system can be given separated by commas, or with repeated directives.
+func getSystemAliasesFromComment(comment string) map[string][]string {
+	var aliases map[string][]string
+	for _, field := range strings.Fields(comment) {
+		if !strings.HasPrefix(field, aliasDirectivePrefix) {
+			continue
+		}
+		system, names, ok := strings.Cut(strings.TrimPrefix(field, aliasDirectivePrefix), `=`)
+		if !ok || system == "" {
+			continue
+		}
+		for _, name := range strings.Split(names, `,`) {
+			if name == "" {
+				continue
+			}
+			if aliases == nil {
+				aliases = map[string][]string{}
 			}
+			aliases[system] = append(aliases[system], name)
 		}
 	}
+	return aliases
+}
 
-	formatted, err := imports.Process(pkg, vBuff.Bytes(), nil)
+// getWeightFromComment looks for a `weight=N` directive in a value comment, and returns the default weight without one.
+func getWeightFromComment(comment string) (int, error) {
+	val, ok := getCommentDirective(comment, weightDirective)
+	if !ok {
+		return defaultWeight, nil
+	}
+	weight, err := strconv.Atoi(val)
 	if err != nil {
-		err = fmt.Errorf("generate: error formatting code %s\n\n%s", err, vBuff.String())
+		return 0, err
 	}
-	return formatted, err
-}
-
-// updateTemplates will update the lookup map for validation checks that are
-// allowed within the template engine.
-func (g *Generator) updateTemplates() {
-	for _, template := range g.t.Templates() {
-		g.knownTemplates[template.Name()] = template
+	if weight < 1 {
+		return 0, fmt.Errorf("weight must be positive, got %d", weight)
 	}
+	return weight, nil
 }
 
-// parseFile simply calls the go/parser ParseFile function with an empty token.FileSet
-func (g *Generator) parseFile(fileName string) (*ast.File, error) {
-	// Parse the file given in arguments
-	return parser.ParseFile(g.fileSet, fileName, nil, parser.ParseComments)
+// getHexFromComment looks for a `hex=#rrggbb` (or `hex=#rgb`) directive in a value comment, and returns an empty string without one.
+func getHexFromComment(comment string) (string, error) {
+	val, ok := getCommentDirective(comment, hexDirective)
+	if !ok {
+		return "", nil
+	}
+	digits := strings.TrimPrefix(val, `#`)
+	if !strings.HasPrefix(val, `#`) || (len(digits) != 3 && len(digits) != 6) {
+		return "", fmt.Errorf("hex color must be formatted as #rgb or #rrggbb, got %q", val)
+	}
+	if _, err := strconv.ParseUint(digits, 16, 32); err != nil {
+		return "", fmt.Errorf("hex color must be formatted as #rgb or #rrggbb, got %q", val)
+	}
+	return val, nil
 }
 
-// parseEnum looks for the ENUM(x,y,z) formatted documentation from the type definition
-func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
-
-	if ts.Doc == nil {
-		return nil, errors.New("No Doc on Enum")
+// getHTTPStatusFromComment looks for a `httpStatus=N` directive in a value comment, and returns 0 without one.
+func getHTTPStatusFromComment(comment string) (int, error) {
+	val, ok := getCommentDirective(comment, httpStatusDirective)
+	if !ok {
+		return 0, nil
 	}
+	status, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, err
+	}
+	if status < 100 || status > 599 {
+		return 0, fmt.Errorf("http status must be between 100 and 599, got %d", status)
+	}
+	return status, nil
+}
 
-	enum := &Enum{}
-
-	enum.Name = ts.Name.Name
-	enum.Type = fmt.Sprintf("%s", ts.Type)
-	if !g.noPrefix {
-		enum.Prefix = ts.Name.Name
+// readCSVResource reads the `name,value` rows of a CSV file, relative to the file declaring the type, into
+// enum value declarations.  Rows without a value get the next value, like names without `=` in an ENUM.
+func (g *Generator) readCSVResource(ts *ast.TypeSpec, fileName string) ([]string, error) {
+	if !filepath.IsAbs(fileName) {
+		fileName = filepath.Join(filepath.Dir(g.fileSet.Position(ts.Pos()).Filename), fileName)
 	}
-	if g.prefix != "" {
-		enum.Prefix = g.prefix + enum.Prefix
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
 	}
+	defer f.Close()
 
-	enumDecl := getEnumDeclFromComments(ts.Doc.List)
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed parsing %s", fileName)
+	}
 
-	values := strings.Split(strings.TrimSuffix(strings.TrimPrefix(enumDecl, `ENUM(`), `)`), `,`)
-	var (
-		data     interface{}
-		unsigned bool
-	)
-	if strings.HasPrefix(enum.Type, "u") {
-		data = uint64(0)
-		unsigned = true
-	} else {
-		data = int64(0)
+	firstLine := 1
+	if g.csvResourceHeader && len(records) > 0 {
+		records = records[1:]
+		firstLine = 2
 	}
-	for _, value := range values {
-		var comment string
 
-		// Trim and store comments
-		if strings.Contains(value, parseCommentPrefix) {
-			commentStartIndex := strings.Index(value, parseCommentPrefix)
-			comment = value[commentStartIndex+len(parseCommentPrefix):]
-			comment = strings.TrimSpace(unescapeComment(comment))
-			// value without comment
-			value = value[:commentStartIndex]
+	values := make([]string, 0, len(records))
+	for i, record := range records {
+		name, value := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if name == "" || strings.Contains(name, `=`) || strings.Contains(name, parseCommentPrefix) {
+			return nil, fmt.Errorf("%s line %d: invalid name %q", fileName, firstLine+i, name)
 		}
-
-		// Make sure to leave out any empty parts
 		if value != "" {
-			if strings.Contains(value, `=`) {
-				// Get the value specified and set the data to that value.
-				equalIndex := strings.Index(value, `=`)
-				dataVal := strings.TrimSpace(value[equalIndex+1:])
-				if dataVal != "" {
-					if unsigned {
-						newData, err := strconv.ParseUint(dataVal, 10, 64)
-						if err != nil {
-							err = errors.Wrapf(err, "failed parsing the data part of enum value '%s'", value)
-							fmt.Println(err)
-							return nil, err
-						}
-						data = newData
-					} else {
-						newData, err := strconv.ParseInt(dataVal, 10, 64)
-						if err != nil {
-							err = errors.Wrapf(err, "failed parsing the data part of enum value '%s'", value)
-							fmt.Println(err)
-							return nil, err
-						}
-						data = newData
-					}
-					value = value[:equalIndex]
-				} else {
-					value = strings.TrimSuffix(value, `=`)
-					fmt.Printf("Ignoring enum with '=' but no value after: %s\n", value)
-				}
-			}
-			rawName := strings.TrimSpace(value)
-			name := strings.Title(rawName)
-			prefixedName := name
-			if name != skipHolder {
-				prefixedName = enum.Prefix + name
-				prefixedName = sanitizeValue(prefixedName)
-				if !g.leaveSnakeCase {
-					prefixedName = snakeToCamelCase(prefixedName)
-				}
-			}
+			name = name + `=` + value
+		}
+		values = append(values, name)
+	}
+	return values, nil
+}
 
-			ev := EnumValue{Name: name, RawName: rawName, PrefixedName: prefixedName, Value: data, Comment: comment}
-			enum.Values = append(enum.Values, ev)
-			data = increment(data)
+// validateEmptyAs makes sure at least one of the generated enums has the value WithEmptyAs names,
+// so a misspelled name fails generation rather than being silently ignored.
+func (g *Generator) validateEmptyAs(enums []*Enum) error {
+	if g.emptyAs == "" || len(enums) == 0 {
+		return nil
+	}
+	var names []string
+	for _, enum := range enums {
+		if emptyValueName(enum, g.emptyAs) != "" {
+			return nil
 		}
+		names = append(names, strconv.Quote(enum.Name))
 	}
+	return fmt.Errorf("generate: %q, the value to parse empty strings as, is not a value of %s", g.emptyAs, strings.Join(names, ", "))
+}
 
-	// fmt.Printf("###\nENUM: %+v\n###\n", enum)
+// emptyValueName returns the constant name of the value named name, or an empty string if the enum has no such value.
+func emptyValueName(enum *Enum, name string) string {
+	for _, val := range enum.Values {
+		if val.Name != skipHolder && strings.EqualFold(val.Name, name) {
+			return val.PrefixedName
+		}
+	}
+	return ""
+}
 
-	return enum, nil
+func increment(d interface{}, step uint64) interface{} {
+	switch v := d.(type) {
+	case uint64:
+		return v + step
+	case int64:
+		return v + int64(step)
+	}
+	return d
 }
 
-func increment(d interface{}) interface{} {
+// nextFlag returns the lowest power of two above d, so bit flag values that follow an explicit value use a fresh bit.
+func nextFlag(d interface{}) interface{} {
 	switch v := d.(type) {
 	case uint64:
-		return v + 1
+		return uint64(1) << bits.Len64(v)
 	case int64:
-		return v + 1
+		if v <= 0 {
+			return int64(1)
+		}
+		return int64(1) << bits.Len64(uint64(v))
 	}
 	return d
 }
@@ -436,25 +2056,23 @@ func unescapeComment(comment string) string {
 // identifier syntax as described here: https://golang.org/ref/spec#Identifiers
 // identifier = letter { letter | unicode_digit }
 // where letter can be unicode_letter or '_'
-func sanitizeValue(value string) string {
+// Values that do not start with a letter get the numericPrefix tacked on the front.
+func sanitizeValue(value, numericPrefix string, aliases map[string]string) string {
 	// Keep skip value holders
 	if value == skipHolder {
 		return skipHolder
 	}
 
-	replacedValue := value
-	for k, v := range replacementNames {
-		replacedValue = strings.ReplaceAll(replacedValue, k, v)
-	}
+	replacedValue := replaceAliases(value, aliases)
 
 	nameBuilder := strings.Builder{}
 	nameBuilder.Grow(len(replacedValue))
 
 	for i, r := range replacedValue {
 		// If the start character is not a unicode letter (this check includes the case of '_')
-		// then we need to add an exported prefix, so tack on a 'X' at the beginning
+		// then we need to add an exported prefix, so tack on the numeric prefix at the beginning
 		if i == 0 && !unicode.IsLetter(r) {
-			nameBuilder.WriteRune('X')
+			nameBuilder.WriteString(numericPrefix)
 		}
 
 		if unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' {
@@ -465,6 +2083,189 @@ func sanitizeValue(value string) string {
 	return nameBuilder.String()
 }
 
+// replaceAliases swaps out every alias key found in the value with its replacement.
+func replaceAliases(value string, aliases map[string]string) string {
+	for k, v := range aliases {
+		value = strings.ReplaceAll(value, k, v)
+	}
+	return value
+}
+
+// validateContiguous makes sure the enum values form a gapless sequence starting at 0, and reports the first gap otherwise.
+func validateContiguous(enum *Enum, allowSkipped bool) error {
+	taken := map[uint64]bool{}
+	for _, val := range enum.Values {
+		if val.Name == skipHolder && !allowSkipped {
+			continue
+		}
+		switch v := val.Value.(type) {
+		case int64:
+			if v < 0 {
+				return fmt.Errorf("generate: enum %q is not contiguous, value %q is negative (%d)", enum.Name, val.RawName, v)
+			}
+			taken[uint64(v)] = true
+		case uint64:
+			taken[v] = true
+		}
+	}
+	for i := uint64(0); i < uint64(len(taken)); i++ {
+		if !taken[i] {
+			return fmt.Errorf("generate: enum %q is not contiguous, value %d is missing", enum.Name, i)
+		}
+	}
+	return nil
+}
+
+// validateUniqueValues makes sure no two values of the enum share the same underlying value, whether
+// they were assigned explicitly or picked up through auto-increment.
+// Names may only share a value on purpose, by marking one of them as canonical.
+func validateUniqueValues(enum *Enum) error {
+	shared := map[interface{}]bool{}
+	for _, val := range enum.Values {
+		if val.Name != skipHolder && val.Canonical {
+			shared[val.Value] = true
+		}
+	}
+	seen := map[interface{}]string{}
+	for _, val := range enum.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		prev, ok := seen[val.Value]
+		if !ok {
+			seen[val.Value] = val.RawName
+		} else if !shared[val.Value] {
+			return fmt.Errorf("generate: enum %q has duplicate value %v, used by both %q and %q (mark one of them canonical if they are meant to share it)", enum.Name, val.Value, prev, val.RawName)
+		}
+	}
+	return nil
+}
+
+// validateBitflag makes sure the enum can be generated as a bit flag enum.
+func validateBitflag(enum *Enum, golangFlag bool) error {
+	if enum.Type == stringEnumType {
+		return fmt.Errorf("generate: enum %q is a string enum, which can not be a bit flag enum", enum.Name)
+	}
+	if golangFlag {
+		return fmt.Errorf("generate: enum %q can not have both the bit flag and golang flag methods, as both generate a Set method", enum.Name)
+	}
+	return nil
+}
+
+// validateStringEnumOptions makes sure the string enum template supports every option enabled for the enum,
+// so that options are never left out of the output silently.
+func validateStringEnumOptions(enum *Enum, data map[string]interface{}) error {
+	var unsupported []string
+	for key, val := range data {
+		if stringEnumDataKeys[key] {
+			continue
+		}
+		switch val := val.(type) {
+		case bool:
+			if !val {
+				continue
+			}
+		case string:
+			if val == "" {
+				continue
+			}
+		}
+		unsupported = append(unsupported, key)
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return fmt.Errorf("generate: enum %q is a string enum, which does not support the %s option(s)", enum.Name, strings.Join(unsupported, ", "))
+	}
+	return nil
+}
+
+// validateSQLDual makes sure the enum can be given a Scan for both string and integer columns, writing values as valueAs.
+func validateSQLDual(enum *Enum, valueAs string, sqlEnabled bool) error {
+	if valueAs != "string" && valueAs != "int" {
+		return fmt.Errorf("generate: the sql dual value representation must be either string or int, not %q", valueAs)
+	}
+	if enum.Type == stringEnumType {
+		return fmt.Errorf("generate: enum %q is a string enum, which has no integer value to scan", enum.Name)
+	}
+	for _, format := range enum.Formats {
+		sqlEnabled = sqlEnabled || format == "sql"
+	}
+	if sqlEnabled {
+		return fmt.Errorf("generate: enum %q can not have both the sql dual and the other sql methods, as both generate a Scan method", enum.Name)
+	}
+	return nil
+}
+
+// validateFuzzyNames makes sure no two values of the enum are matched by the same name once normalized for the fuzzy parse.
+func validateFuzzyNames(enum *Enum) error {
+	seen := map[string]EnumValue{}
+	for _, val := range enum.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		name := FuzzyName(val.RawName)
+		if other, ok := seen[name]; ok && other.Value != val.Value {
+			return fmt.Errorf("generate: enum %q values %q and %q can not be told apart by the fuzzy parse", enum.Name, other.RawName, val.RawName)
+		}
+		seen[name] = val
+	}
+	return nil
+}
+
+// validateByteCodec makes sure the declaration order index of every value of the enum fits in a single byte.
+func validateByteCodec(enum *Enum) error {
+	count := 0
+	for _, val := range enum.Values {
+		if val.Name != skipHolder {
+			count++
+		}
+	}
+	if count > 256 {
+		return fmt.Errorf("generate: enum %q has %d values, the byte codec supports at most 256", enum.Name, count)
+	}
+	return nil
+}
+
+// validateStrictNames makes sure none of the enum's value names needed sanitizing to become a valid identifier.
+func validateStrictNames(enum *Enum, aliases map[string]string) error {
+	for _, val := range enum.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		name := replaceAliases(enum.Prefix+val.Name, aliases)
+		if sanitizeValue(name, defaultNumericPrefix, aliases) != name {
+			return fmt.Errorf("generate: enum %q value %q is not a valid identifier (would be sanitized to %q)", enum.Name, val.RawName, val.PrefixedName)
+		}
+	}
+	return nil
+}
+
+// toSnakeCase converts a name to lowercase words separated by underscores, splitting on any non alphanumeric
+// character and on lower to upper case changes, e.g. "InProgress" and "in-progress" both become "in_progress".
+func toSnakeCase(value string) string {
+	var words []string
+	var word []rune
+	runes := []rune(value)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsNumber(r):
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		case unicode.IsUpper(r) && len(word) > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsNumber(runes[i-1])):
+			words = append(words, string(word))
+			word = nil
+		}
+		word = append(word, unicode.ToLower(r))
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return strings.Join(words, "_")
+}
+
 func snakeToCamelCase(value string) string {
 	parts := strings.Split(value, "_")
 	for i, part := range parts {
@@ -478,7 +2279,7 @@ func snakeToCamelCase(value string) string {
 // getEnumDeclFromComments parses the array of comment strings and creates a single Enum Declaration statement
 // that is easier to deal with for the remainder of parsing.  It turns multi line declarations and makes a single
 // string declaration.
-func getEnumDeclFromComments(comments []*ast.Comment) string {
+func getEnumDeclFromComments(comments []*ast.Comment) (string, error) {
 	parts := []string{}
 	store := false
 
@@ -524,15 +2325,146 @@ func getEnumDeclFromComments(comments []*ast.Comment) string {
 			if enumParamLevel > 0 {
 				// Store other lines
 				store = true
+			} else if trimmed != "" {
+				// The declaration ends on the same line, drop anything that follows it
+				if end := strings.Index(trimmed, ")"); end >= 0 {
+					parts[len(parts)-1] = trimmed[:end]
+				}
 			}
 		}
 	}
 
 	if enumParamLevel > 0 {
-		fmt.Println("ENUM Parse error, there is a dangling '(' in your comment.")
+		return "", errors.New("ENUM parse error, there is a dangling '(' in your comment")
 	}
 	joined := fmt.Sprintf("ENUM(%s)", strings.Join(parts, `,`))
-	return joined
+	return joined, nil
+}
+
+// getDocFromComments returns the lines of the comments surrounding the ENUM declaration, without the type directives,
+// to document the generated constants with.
+func getDocFromComments(comments []*ast.Comment) []string {
+	doc := []string{}
+	for _, line := range getLinesOutsideEnumDecl(comments) {
+		doc = appendDocLine(doc, line)
+	}
+
+	for len(doc) > 0 && doc[len(doc)-1] == "" {
+		doc = doc[:len(doc)-1]
+	}
+	return doc
+}
+
+// getLinesOutsideEnumDecl returns the lines of the comments with the ENUM(...) declaration cut out of them, so the
+// value comments within the declaration are never mistaken for type level text.  A line the declaration starts or
+// ends on is kept with the part before or after it.
+func getLinesOutsideEnumDecl(comments []*ast.Comment) []string {
+	lines := []string{}
+	for _, comment := range comments {
+		lines = append(lines, breakCommentIntoLines(comment)...)
+	}
+
+	outside := []string{}
+	enumParamLevel := 0
+	for _, line := range lines {
+		if enumParamLevel == 0 {
+			startIndex := strings.Index(line, `ENUM(`)
+			if startIndex < 0 {
+				outside = append(outside, line)
+				continue
+			}
+			outside = append(outside, line[:startIndex])
+			line = line[startIndex+len(`ENUM(`):]
+			enumParamLevel = 1
+		}
+		paramLevel, _ := parseLinePart(line)
+		enumParamLevel += paramLevel
+		if enumParamLevel <= 0 {
+			// End ENUM Declaration, keep anything that follows it
+			enumParamLevel = 0
+			outside = append(outside, line[strings.LastIndex(line, `)`)+1:])
+		}
+	}
+	return outside
+}
+
+// appendDocLine appends a doc comment line without the type directives, collapsing consecutive empty lines.
+func appendDocLine(doc []string, line string) []string {
+	if start := strings.Index(line, `PROTO(`); start >= 0 {
+		if end := strings.Index(line[start:], `)`); end >= 0 {
+			line = line[:start] + line[start+end+1:]
+		}
+	}
+	fields := strings.Fields(line)
+	kept := fields[:0]
+	for _, field := range fields {
+		if !strings.HasPrefix(field, formatsDirective) && !strings.HasPrefix(field, stepDirective) && !strings.HasPrefix(field, xmlNameDirective) {
+			kept = append(kept, field)
+		}
+	}
+	if len(kept) != len(fields) {
+		line = strings.Join(kept, " ")
+	}
+	// Drop the leading asterisks of /** ... */ style comments
+	line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), `*`))
+	if strings.Trim(line, ".,;:") == "" {
+		// Punctuation closing the ENUM declaration, like `).`
+		line = ""
+	}
+	if line == "" && (len(doc) == 0 || doc[len(doc)-1] == "") {
+		return doc
+	}
+	return append(doc, line)
+}
+
+// getFormatsFromComments looks for a `formats=json,sql` directive in the comments and returns the
+// requested formats, or nil when there is no such directive.
+func getFormatsFromComments(comments []*ast.Comment) []string {
+	if formats, ok := getDirectiveFromComments(comments, formatsDirective); ok {
+		return strings.Split(formats, `,`)
+	}
+	return nil
+}
+
+// getDirectiveFromComments looks for a `name=value` directive in the comments outside the ENUM(...) declaration,
+// and returns its value.
+func getDirectiveFromComments(comments []*ast.Comment, directive string) (string, bool) {
+	for _, line := range getLinesOutsideEnumDecl(comments) {
+		for _, field := range strings.Fields(line) {
+			if strings.HasPrefix(field, directive) {
+				return strings.TrimPrefix(field, directive), true
+			}
+		}
+	}
+	return "", false
+}
+
+// validateFormats makes sure every format requested by the enum's formats directive is known.
+func validateFormats(enum *Enum) error {
+	for _, format := range enum.Formats {
+		if _, ok := enumFormats[format]; !ok {
+			return fmt.Errorf("generate: enum %q requests unknown format %q, valid formats are [json, text, yaml, sql, flag, toml]", enum.Name, format)
+		}
+	}
+	return nil
+}
+
+// getProtoTypeFromComments looks for a `PROTO(pkg.Type)` directive in the comments and returns the
+// protobuf type named within it.
+func getProtoTypeFromComments(comments []*ast.Comment) string {
+	for _, comment := range comments {
+		for _, line := range breakCommentIntoLines(comment) {
+			startIndex := strings.Index(line, `PROTO(`)
+			if startIndex < 0 {
+				continue
+			}
+			line = line[startIndex+len(`PROTO(`):]
+			if end := strings.Index(line, `)`); end >= 0 {
+				return strings.TrimSpace(line[:end])
+			}
+		}
+	}
+	return ""
 }
 
 func parseLinePart(line string) (paramLevel int, trimmed string) {
@@ -540,7 +2472,13 @@ func parseLinePart(line string) (paramLevel int, trimmed string) {
 	comment := ""
 	if idx := strings.Index(line, parseCommentPrefix); idx >= 0 {
 		trimmed = line[:idx]
-		comment = "//" + url.QueryEscape(strings.TrimSpace(line[idx+2:]))
+		text := strings.TrimSpace(line[idx+2:])
+		// A comment runs to the end of the line, so an unmatched trailing paren is the one closing the declaration.
+		if strings.HasSuffix(text, `)`) && strings.Count(text, `)`) > strings.Count(text, `(`) {
+			text = strings.TrimSpace(strings.TrimSuffix(text, `)`))
+		}
+		// The comment is escaped, so its commas and parens are never taken as part of the declaration.
+		comment = "//" + url.QueryEscape(text)
 	}
 	trimmed = trimAllTheThings(trimmed)
 	trimmed += comment
@@ -609,27 +2547,43 @@ func (g *Generator) inspect(f ast.Node) map[string]*ast.TypeSpec {
 	return enums
 }
 
-// copyDocsToSpecs will take the GenDecl level documents and copy them
-// to the children Type and Value specs.  I think this is actually working
-// around a bug in the AST, but it works for now.
+// copyGenDeclCommentsToSpecs will make sure each Type and Value spec ends up with the
+// comment that describes it in its Doc field.  The precedence is:
+//  1. The spec's own Doc comment (the comment above the spec inside a grouped declaration).
+//  2. The spec's trailing line Comment (e.g. `Color int // ENUM(red, green)`).
+//  3. The GenDecl level Doc comment (the comment above the `type` keyword).
+//
+// The go parser attaches the doc of an ungrouped declaration to the GenDecl rather than the
+// spec, so for those the GenDecl doc is the spec's own Doc, and comes before the line Comment.
 func copyGenDeclCommentsToSpecs(x *ast.GenDecl) {
-	// Copy the doc spec to the type or value spec
-	// cause they missed this... whoops
-	if x.Doc != nil {
-		for _, spec := range x.Specs {
-			switch s := spec.(type) {
-			case *ast.TypeSpec:
-				if s.Doc == nil {
-					s.Doc = x.Doc
-				}
-			case *ast.ValueSpec:
-				if s.Doc == nil {
-					s.Doc = x.Doc
-				}
-			}
+	for _, spec := range x.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			s.Doc = specDoc(ownDoc(x, s.Doc), s.Comment, x.Doc)
+		case *ast.ValueSpec:
+			s.Doc = specDoc(ownDoc(x, s.Doc), s.Comment, x.Doc)
 		}
 	}
+}
+
+// ownDoc returns the doc comment of a spec, which is the doc of the GenDecl when the declaration is not grouped.
+func ownDoc(x *ast.GenDecl, doc *ast.CommentGroup) *ast.CommentGroup {
+	if doc == nil && !x.Lparen.IsValid() {
+		return x.Doc
+	}
+	return doc
+}
 
+// specDoc returns the first non nil comment group in order of precedence.
+func specDoc(doc, comment, declDoc *ast.CommentGroup) *ast.CommentGroup {
+	switch {
+	case doc != nil:
+		return doc
+	case comment != nil:
+		return comment
+	default:
+		return declDoc
+	}
 }
 
 // isTypeSpecEnum checks the comments on the type spec to determine if there is an enum
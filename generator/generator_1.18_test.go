@@ -6,7 +6,14 @@ package generator
 import (
 	"fmt"
 	"go/parser"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/bradleyjkemp/cupaloy"
@@ -206,3 +213,2046 @@ func Test118AliasParsing(t *testing.T) {
 		})
 	}
 }
+
+func Test118ParseAliasEntries(t *testing.T) {
+	aliases, err := ParseAliasEntries([]string{`!:Bang,a:a`, `@:AT`})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"!": "Bang", "a": "a", "@": "AT"}, aliases)
+	assert.Empty(t, replacementNames, "parsing must not touch the package level aliases")
+
+	_, err = ParseAliasEntries([]string{`!:Bang,oops`})
+	require.EqualError(t, err, `invalid formatted alias entry "oops", must be in the format "key:value"`)
+}
+
+func Test118TemplateDir(t *testing.T) {
+	g := NewGenerator().
+		WithTemplateDir("../example")
+	require.NoError(t, g.WithTemplates("user_template.tmpl"))
+
+	imported, err := g.GenerateFromFile(testExampleFiles["og"])
+	require.NoError(t, err)
+	assert.Contains(t, string(imported), "func ParseColorExample() bool")
+}
+
+func Test118TemplateNextToSource(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "source.go"), []byte("package test\n\n// ENUM(on, off)\ntype Switch int\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "local.tmpl"), []byte("// Local{{.enum.Name}} comes from the template next to the source.\nfunc Local{{.enum.Name}}() {}\n"), 0o644))
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(t.TempDir()))
+	defer func() { require.NoError(t, os.Chdir(wd)) }()
+
+	g := NewGenerator()
+	require.NoError(t, g.WithTemplates("local.tmpl", "*cal.tmpl"))
+
+	imported, err := g.GenerateFromFile(filepath.Join(dir, "source.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(imported), "func LocalSwitch() {}")
+
+	g = NewGenerator().WithTemplateDir(".")
+	assert.EqualError(t, g.WithTemplates("local.tmpl"), "failed parsing user templates: open local.tmpl: no such file or directory", "a template dir takes precedence over the source directory")
+}
+
+func Test118ProtoInterop(t *testing.T) {
+	input := `package test
+	// PROTO(testpb.Color)
+	// ENUM(Red, Green, Blue)
+	type Color int32
+	`
+	g := NewGenerator().
+		WithProtoInterop()
+	f, err := parser.ParseFile(g.fileSet, "TestProtoInterop", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "func (x Color) ToProto() testpb.Color {")
+	assert.Contains(t, string(output), "func ColorFromProto(x testpb.Color) Color {")
+
+	t.Run("missing directive", func(t *testing.T) {
+		input := `package test
+		// ENUM(Red, Green, Blue)
+		type Color int32
+		`
+		f, err := parser.ParseFile(g.fileSet, "TestProtoInteropMissing", input, parser.ParseComments)
+		require.NoError(t, err)
+
+		_, err = g.Generate(f)
+		require.EqualError(t, err, `generate: enum "Color" is missing a PROTO(...) directive required for protobuf interop`)
+	})
+}
+
+func Test118StrictNames(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		err   string
+	}{
+		"clean": {
+			input: `package test
+			// ENUM(light_blue, Red, green)
+			type Color int
+			`,
+		},
+		"spaces": {
+			input: `package test
+			// ENUM(light blue, Red)
+			type Color int
+			`,
+			err: `generate: enum "Color" value "light blue" is not a valid identifier (would be sanitized to "ColorLightBlue")`,
+		},
+		"symbols": {
+			input: `package test
+			// ENUM(Red, gr@y!)
+			type Color int
+			`,
+			err: `generate: enum "Color" value "gr@y!" is not a valid identifier (would be sanitized to "ColorGrY")`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator().
+				WithStrictNames()
+			f, err := parser.ParseFile(g.fileSet, "TestStrictNames", tc.input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = g.Generate(f)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test118StandardLibraryImportsOnly(t *testing.T) {
+	input := `package test
+	// ENUM(alpha, beta, gamma)
+	// PROTO(GreekProto)
+	type Greek int
+
+	// GreekProto stands in for a protobuf generated enum.
+	type GreekProto int32
+	`
+	// The options taking arguments, with arguments that work for the input.
+	arguments := map[string][]interface{}{
+		"WithPrefix":            {"My"},
+		"WithNumericPrefix":     {"N"},
+		"WithAliases":           {map[string]string{"+": "Plus"}},
+		"WithEmptyAs":           {"alpha"},
+		"WithRequireContiguous": {false},
+		"WithHTTPStatus":        {500},
+		"WithStringTemplate":    {"greek-{{.Name}}"},
+		"WithMethods":           {"String"},
+		"WithSQLDual":           {"int"},
+		"WithJSONZeroRepr":      {`""`},
+		"WithTemplateDir":       {"."},
+	}
+	// The options that are allowed to import something else, and the import path prefix they may use.
+	exceptions := map[string]string{
+		// The bson interfaces take the bsontype.Type of the mongo driver, there is no standard library type to use instead.
+		"WithBSON": "go.mongodb.org/mongo-driver/",
+		// The pgx text interfaces take a pgtype.Text, which only pgx declares.
+		"WithPgx": "github.com/jackc/pgx/v5/",
+	}
+
+	generatorType := reflect.TypeOf(NewGenerator())
+	for i := 0; i < generatorType.NumMethod(); i++ {
+		method := generatorType.Method(i)
+		if !strings.HasPrefix(method.Name, "With") || method.Type.NumOut() != 1 || method.Type.Out(0) != generatorType {
+			continue
+		}
+		t.Run(method.Name, func(t *testing.T) {
+			g := NewGenerator()
+			args := []reflect.Value{reflect.ValueOf(g)}
+			if method.Type.NumIn() > 1 {
+				values, ok := arguments[method.Name]
+				require.True(t, ok, "add arguments for %s to the test", method.Name)
+				for _, value := range values {
+					args = append(args, reflect.ValueOf(value))
+				}
+			}
+			method.Func.Call(args)
+
+			f, err := parser.ParseFile(g.fileSet, "TestStandardLibraryImportsOnly", input, parser.ParseComments)
+			require.NoError(t, err)
+			output, err := g.Generate(f)
+			require.NoError(t, err)
+
+			f, err = parser.ParseFile(g.fileSet, "", output, parser.ImportsOnly)
+			require.NoError(t, err)
+			require.NotEmpty(t, f.Imports)
+			for _, spec := range f.Imports {
+				path := strings.Trim(spec.Path.Value, `"`)
+				if prefix, ok := exceptions[method.Name]; ok && strings.HasPrefix(path, prefix) {
+					continue
+				}
+				assert.NotContains(t, strings.Split(path, "/")[0], ".", "%s is not a standard library import", path)
+			}
+		})
+	}
+}
+
+func Test118GroupedDeclarationComments(t *testing.T) {
+	input := `package test
+	// Types for the test.
+	type (
+		// Plain is not an enum.
+		Plain int
+		Trailing int // ENUM(first, second)
+		Undocumented int
+		// Documented ENUM(third, fourth)
+		Documented int
+	)
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestGroupedDeclarationComments", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enums := g.inspect(f)
+	require.Len(t, enums, 2)
+	require.Contains(t, enums, "Trailing")
+	require.Contains(t, enums, "Documented")
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "TrailingFirst Trailing = iota")
+	assert.Contains(t, string(output), "DocumentedThird Documented = iota")
+}
+
+func Test118UngroupedDeclarationLineComment(t *testing.T) {
+	input := `package test
+	// ENUM(red, green)
+	type Color int // nolint:gochecknoglobals
+
+	type Shade int // ENUM(light, dark)
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestUngroupedDeclarationLineComment", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enums := g.inspect(f)
+	require.Len(t, enums, 2)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "ColorRed Color = iota", "the doc above the type comes before its line comment")
+	assert.Contains(t, string(output), "ShadeLight Shade = iota", "a line comment is used when there is no doc")
+}
+
+func Test118GeneratorAliases(t *testing.T) {
+	input := `package test
+	// ENUM(a+b, a#b)
+	type Op int
+	`
+	tests := map[string]struct {
+		aliases  map[string]string
+		expected []string
+	}{
+		"words": {
+			aliases:  map[string]string{"+": "Plus", "#": "Hash"},
+			expected: []string{"OpAPlusB", "OpAHashB"},
+		},
+		"symbols": {
+			aliases:  map[string]string{"+": "And", "#": "Or"},
+			expected: []string{"OpAAndB", "OpAOrB"},
+		},
+	}
+
+	var wg sync.WaitGroup
+	for name, tc := range tests {
+		wg.Add(1)
+		go func(name string, aliases map[string]string, expected []string) {
+			defer wg.Done()
+			g := NewGenerator().
+				WithAliases(aliases)
+			f, err := parser.ParseFile(g.fileSet, name, input, parser.ParseComments)
+			if !assert.NoError(t, err) {
+				return
+			}
+			for i := 0; i < 50; i++ {
+				output, err := g.Generate(f)
+				if !assert.NoError(t, err) {
+					return
+				}
+				for _, value := range expected {
+					assert.Contains(t, string(output), value, name)
+				}
+			}
+		}(name, tc.aliases, tc.expected)
+	}
+	wg.Wait()
+	assert.Empty(t, replacementNames)
+}
+
+func Test118TemplateCollision(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "collision.tmpl")
+	require.NoError(t, os.WriteFile(filename, []byte(`{{define "stringer"}}// Replaced{{end}}`), 0o644))
+
+	g := NewGenerator()
+	err := g.WithTemplates(filename)
+	require.EqualError(t, err, `user template "stringer" collides with a built in template of the same name`)
+
+	t.Run("templates defined in a template file", func(t *testing.T) {
+		used := filepath.Join(dir, "used.tmpl")
+		require.NoError(t, os.WriteFile(used, []byte(`{{template "helper" .}}{{define "helper"}}
+// Helper{{.enum.Name}} is written once for each enum.
+func Helper{{.enum.Name}}() {}
+{{end}}`), 0o644))
+		g := NewGenerator()
+		require.NoError(t, g.WithTemplates(used))
+		imported, err := g.GenerateFromFile(testExampleFiles["og"])
+		require.NoError(t, err)
+		assert.Equal(t, 1, strings.Count(string(imported), "func HelperColor() {}"))
+
+		unused := filepath.Join(dir, "unused.tmpl")
+		require.NoError(t, os.WriteFile(unused, []byte(`{{template "helpr" .}}{{define "helper"}}{{end}}{{define "extra"}}{{end}}`), 0o644))
+		g = NewGenerator()
+		require.NoError(t, g.WithTemplates(unused))
+		_, err = g.GenerateFromFile(testExampleFiles["og"])
+		assert.EqualError(t, err, `user template "extra" is never executed, none of the template files use it`)
+	})
+
+	t.Run("re-adding a user template", func(t *testing.T) {
+		g := NewGenerator().WithTemplateDir("../example")
+		require.NoError(t, g.WithTemplates("user_template.tmpl"))
+		require.NoError(t, g.WithTemplates("user_template.tmpl"))
+		assert.Equal(t, []string{"user_template.tmpl"}, g.userTemplateNames)
+	})
+}
+
+func Test118PrefixedStrings(t *testing.T) {
+	input := `package test
+	// ENUM(red, light-blue)
+	type Color int
+	`
+	tests := map[string]struct {
+		g        *Generator
+		expected string
+	}{
+		"prefixed": {
+			g:        NewGenerator().WithPrefixedStrings(),
+			expected: `const _ColorName = "ColorRedColorLightBlue"`,
+		},
+		"noprefix": {
+			g:        NewGenerator().WithPrefixedStrings().WithNoPrefix(),
+			expected: `const _ColorName = "redlight-blue"`,
+		},
+		"forcelower": {
+			g:        NewGenerator().WithPrefixedStrings().WithForceLower(),
+			expected: `const _ColorName = "colorredcolorlightblue"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := parser.ParseFile(tc.g.fileSet, "TestPrefixedStrings", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := tc.g.Generate(f)
+			require.NoError(t, err)
+			assert.Contains(t, string(output), tc.expected)
+		})
+	}
+}
+
+func Test118GenericEnum(t *testing.T) {
+	input := `package test
+	// ENUM(first, second)
+	type Generic[T any] int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestGenericEnum", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.Generate(f)
+	require.EqualError(t, err, `generate: enum "Generic" is a generic type, generic enum types are not supported`)
+}
+
+func Test118WeightFromComment(t *testing.T) {
+	tests := map[string]struct {
+		comment string
+		weight  int
+		err     string
+	}{
+		"no comment":   {comment: "", weight: 1},
+		"no directive": {comment: "just a comment", weight: 1},
+		"only weight":  {comment: "weight=4", weight: 4},
+		"with text":    {comment: "a common value weight=10", weight: 10},
+		"not a number": {comment: "weight=lots", err: `strconv.Atoi: parsing "lots": invalid syntax`},
+		"not positive": {comment: "weight=0", err: "weight must be positive, got 0"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			weight, err := getWeightFromComment(tc.comment)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.weight, weight)
+		})
+	}
+}
+
+func Test118HexFromComment(t *testing.T) {
+	tests := map[string]struct {
+		comment string
+		hex     string
+		err     string
+	}{
+		"no comment":   {comment: "", hex: ""},
+		"no directive": {comment: "just a comment", hex: ""},
+		"long form":    {comment: "hex=#ff00AA", hex: "#ff00AA"},
+		"short form":   {comment: "red-ish hex=#f00", hex: "#f00"},
+		"no hash":      {comment: "hex=ff0000", err: `hex color must be formatted as #rgb or #rrggbb, got "ff0000"`},
+		"bad length":   {comment: "hex=#ff00", err: `hex color must be formatted as #rgb or #rrggbb, got "#ff00"`},
+		"not hex":      {comment: "hex=#gg0000", err: `hex color must be formatted as #rgb or #rrggbb, got "#gg0000"`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			hex, err := getHexFromComment(tc.comment)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.hex, hex)
+		})
+	}
+}
+
+func Test118DuplicateCanonical(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	usd
+	dollar=0 // canonical
+	buck=0 // canonical
+	)
+	*/
+	type Currency int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestDuplicateCanonical", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enums := g.inspect(f)
+	_, err = g.parseEnumSpec(enums["Currency"])
+	require.EqualError(t, err, `enum "Currency" marks both "dollar" and "buck" as canonical for value 0`)
+}
+
+func Test118DuplicateValues(t *testing.T) {
+	tests := map[string]struct {
+		decl string
+		err  string
+	}{
+		"explicit": {
+			decl: "ENUM(A=1, B=1)",
+			err:  `generate: enum "Status" has duplicate value 1, used by both "A" and "B" (mark one of them canonical if they are meant to share it)`,
+		},
+		"auto increment": {
+			decl: "ENUM(A, B=0)",
+			err:  `generate: enum "Status" has duplicate value 0, used by both "A" and "B" (mark one of them canonical if they are meant to share it)`,
+		},
+		"collision further down": {
+			decl: "ENUM(A, B, C=5, D=4, E)",
+			err:  `generate: enum "Status" has duplicate value 5, used by both "C" and "E" (mark one of them canonical if they are meant to share it)`,
+		},
+		"canonical": {
+			decl: "ENUM(\nA\nB=0 // canonical\n)",
+		},
+		"skipped": {
+			decl: "ENUM(A, _, _=1, B)",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n/*\n" + tc.decl + "\n*/\ntype Status int\n"
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "TestDuplicateValues", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = g.Generate(f)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test118DuplicateNames(t *testing.T) {
+	tests := map[string]struct {
+		decl string
+		err  string
+	}{
+		"repeated": {
+			decl: "ENUM(Foo, Foo)",
+			err:  `enum "Status" value "Foo" collides with "Foo", both are named StatusFoo`,
+		},
+		"camel cased": {
+			decl: "ENUM(foo_bar, fooBar)",
+			err:  `enum "Status" value "fooBar" collides with "foo_bar", both are named StatusFooBar`,
+		},
+		"sanitized": {
+			decl: "ENUM(a-b, a_b, c)",
+			err:  `enum "Status" value "a_b" collides with "a-b", both are named StatusAB`,
+		},
+		"distinct": {
+			decl: "ENUM(foo, bar, _, _)",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n// " + tc.decl + "\ntype Status int\n"
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "TestDuplicateNames", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			enums := g.inspect(f)
+			_, err = g.parseEnumSpec(enums["Status"])
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test118GenerateFromProto(t *testing.T) {
+	g := NewGenerator().
+		WithMarshal().
+		WithNames().
+		WithTypeCheck()
+
+	imported, err := g.GenerateFromProto(`example_test.proto`)
+	require.NoError(t, err, "the generated code type checks on its own")
+
+	output := string(imported)
+	assert.Contains(t, output, "package shippingv1")
+	assert.Contains(t, output, "// ParcelSize is generated from the acme.shipping.v1.Parcel.Size proto enum.\ntype ParcelSize int32\n")
+	assert.Contains(t, output, "TrafficLightUnspecified TrafficLight = iota")
+	assert.Contains(t, output, "TrafficLightGreen TrafficLight = iota + 2")
+	assert.Contains(t, output, `const _TrafficLightName = "TRAFFIC_LIGHT_UNSPECIFIEDTRAFFIC_LIGHT_REDTRAFFIC_LIGHT_YELLOWTRAFFIC_LIGHT_GREEN"`)
+	assert.Contains(t, output, "ParcelSizeUnknown ParcelSize = iota")
+	assert.Contains(t, output, "ParcelSizeOversized ParcelSize = iota + -5")
+	assert.NotContains(t, output, "NotAnEnum")
+
+	outputLines := strings.Split(output, "\n")
+	cupaloy.SnapshotT(t, outputLines)
+}
+
+func Test118GenerateFromProtoErrors(t *testing.T) {
+	_, err := NewGenerator().GenerateFromProto(`missing.proto`)
+	require.Error(t, err)
+
+	tests := map[string]struct {
+		input string
+		err   string
+	}{
+		"no enums":       {input: `syntax = "proto3"; message Empty {}`},
+		"missing number": {input: `enum Broken { BROKEN_A; }`, err: `test.proto:1:23: found ";" but expected [enum field =]`},
+		"bad number":     {input: `enum Broken { BROKEN_A = x; }`, err: `test.proto:1:26: found "non integer" but expected [enum field integer]`},
+		"unclosed":       {input: `message M { enum Open { OPEN_A = 0;`, err: `test.proto:1:36: found "" but expected [enum closing }]`},
+		"non ascii text": {input: `message Café { string ünïcode = 1; /* enum NotAnEnum { } */ }`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			file, err := parseProto("test.proto", tc.input)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, file.enums)
+		})
+	}
+}
+
+func Test118GenerateFromProtoValidation(t *testing.T) {
+	tests := map[string]struct {
+		generator *Generator
+		input     string
+		err       string
+	}{
+		"valid": {
+			generator: NewGenerator(),
+			input:     `enum Mode { MODE_OFF = 0; MODE_ON = 1; }`,
+		},
+		"colliding names": {
+			generator: NewGenerator(),
+			input:     `enum Mode { MODE_A_B = 0; MODE_A__B = 1; }`,
+			err:       `generate: enum "Mode" value "MODE_A__B" collides with "MODE_A_B", both are named ModeAB`,
+		},
+		"alias without allow_alias": {
+			generator: NewGenerator(),
+			input:     `enum Mode { MODE_OFF = 0; MODE_DISABLED = 0; }`,
+			err:       `generate: enum "Mode" has duplicate value 0, used by both "MODE_OFF" and "MODE_DISABLED" (mark one of them canonical if they are meant to share it)`,
+		},
+		"generator options": {
+			generator: NewGenerator().WithRequireContiguous(false),
+			input:     `enum Mode { MODE_OFF = 0; MODE_ON = 2; }`,
+			err:       `generate: enum "Mode" is not contiguous, value 1 is missing`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "mode.proto")
+			require.NoError(t, os.WriteFile(filename, []byte(`syntax = "proto3"; package test; `+tc.input), 0o644))
+
+			_, err := tc.generator.GenerateFromProto(filename)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func Test118FormatsDirective(t *testing.T) {
+	input := `package test
+	// ENUM(pending, done) formats=json,yaml
+	type JSONOnly int
+
+	// SQLAndFlag is stored and configured, but never marshalled.
+	/*
+	ENUM(
+	low
+	high
+	)
+	formats=sql,flag
+	*/
+	type SQLAndFlag int
+
+	// ENUM(on, off)
+	type Global int
+	`
+	g := NewGenerator().
+		WithMarshal()
+	f, err := parser.ParseFile(g.fileSet, "TestFormatsDirective", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "func (x JSONOnly) MarshalText()")
+	assert.NotContains(t, string(output), "func (x *JSONOnly) Scan(")
+	assert.NotContains(t, string(output), "func (x *JSONOnly) Set(")
+	assert.NotContains(t, string(output), "func (x SQLAndFlag) MarshalText()")
+	assert.Contains(t, string(output), "func (x *SQLAndFlag) Scan(")
+	assert.Contains(t, string(output), "func (x *SQLAndFlag) Set(")
+	// Without a directive, the global options still apply.
+	assert.Contains(t, string(output), "func (x Global) MarshalText()")
+	assert.NotContains(t, string(output), "func (x *Global) Scan(")
+
+	outputLines := strings.Split(string(output), "\n")
+	cupaloy.SnapshotT(t, outputLines)
+}
+
+func Test118UnknownFormat(t *testing.T) {
+	input := `package test
+	// ENUM(pending, done) formats=json,xml
+	type Status int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestUnknownFormat", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.Generate(f)
+	require.EqualError(t, err, `generate: enum "Status" requests unknown format "xml", valid formats are [json, text, yaml, sql, flag, toml]`)
+}
+
+func Test118ByteCodec(t *testing.T) {
+	tests := map[string]struct {
+		count int
+		err   string
+	}{
+		"fits": {
+			count: 256,
+		},
+		"oversized": {
+			count: 257,
+			err:   `generate: enum "Wide" has 257 values, the byte codec supports at most 256`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			names := make([]string, tc.count)
+			for i := range names {
+				names[i] = "v" + strconv.Itoa(i)
+			}
+			input := "package test\n// ENUM(" + strings.Join(names, ", ") + ")\ntype Wide int\n"
+			g := NewGenerator().
+				WithByteCodec()
+			f, err := parser.ParseFile(g.fileSet, "TestByteCodec", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := g.Generate(f)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(output), "func (x Wide) Byte() byte {")
+			assert.Contains(t, string(output), "func WideFromByte(b byte) (Wide, error) {")
+		})
+	}
+}
+
+func Test118Bitflag(t *testing.T) {
+	tests := map[string]struct {
+		decl   string
+		values []interface{}
+	}{
+		"signed": {
+			decl:   "ENUM(a, b, c, d)\ntype Flags int",
+			values: []interface{}{int64(1), int64(2), int64(4), int64(8)},
+		},
+		"unsigned": {
+			decl:   "ENUM(a, b, c)\ntype Flags uint32",
+			values: []interface{}{uint64(1), uint64(2), uint64(4)},
+		},
+		"explicit": {
+			decl:   "ENUM(none=0, a, b=8, c, both=3, d)\ntype Flags int",
+			values: []interface{}{int64(0), int64(1), int64(8), int64(16), int64(3), int64(4)},
+		},
+		"skipped": {
+			decl:   "ENUM(a, _, c)\ntype Flags uint",
+			values: []interface{}{uint64(1), uint64(2), uint64(4)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n// " + tc.decl + "\n"
+			g := NewGenerator().
+				WithBitflag()
+			f, err := parser.ParseFile(g.fileSet, "TestBitflag", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			enum, err := g.parseEnumSpec(g.inspect(f)["Flags"])
+			require.NoError(t, err)
+			var values []interface{}
+			for _, val := range enum.Values {
+				values = append(values, val.Value)
+			}
+			assert.Equal(t, tc.values, values)
+		})
+	}
+
+	t.Run("golang flag", func(t *testing.T) {
+		g := NewGenerator().
+			WithBitflag().
+			WithFlag()
+		f, err := parser.ParseFile(g.fileSet, "TestBitflag", "package test\n// ENUM(a, b)\ntype Flags int\n", parser.ParseComments)
+		require.NoError(t, err)
+
+		_, err = g.Generate(f)
+		require.EqualError(t, err, `generate: enum "Flags" can not have both the bit flag and golang flag methods, as both generate a Set method`)
+	})
+
+	t.Run("string enum", func(t *testing.T) {
+		g := NewGenerator().
+			WithBitflag()
+		f, err := parser.ParseFile(g.fileSet, "TestBitflag", "package test\n// ENUM(a, b)\ntype Flags string\n", parser.ParseComments)
+		require.NoError(t, err)
+
+		_, err = g.Generate(f)
+		require.EqualError(t, err, `generate: enum "Flags" is a string enum, which can not be a bit flag enum`)
+	})
+}
+
+func Test118SystemAliasCollision(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	red // alias:legacy=R alias:modern=R
+	rose // alias:legacy=R
+	)
+	*/
+	type Color int
+	`
+	g := NewGenerator().
+		WithSystemAliases()
+	f, err := parser.ParseFile(g.fileSet, "TestSystemAliasCollision", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.parseEnumSpec(g.inspect(f)["Color"])
+	require.EqualError(t, err, `enum "Color" uses the legacy alias "R" for both "red" and "rose"`)
+}
+
+func Test118MaxValue(t *testing.T) {
+	input := `package test
+	// ENUM(a, b=40, c=7, _=90, d)
+	type Sparse int
+
+	// ENUM(x=-5, y=-2)
+	type Negative int
+
+	// ENUM(big=18446744073709551615, small=1)
+	type Wide uint64
+	`
+	g := NewGenerator().
+		WithMaxValue()
+	f, err := parser.ParseFile(g.fileSet, "TestMaxValue", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "const SparseMaxValue = 91\n")
+	assert.Contains(t, string(output), "const NegativeMaxValue = -2\n")
+	assert.Contains(t, string(output), "const WideMaxValue = 18446744073709551615\n")
+
+	outputLines := strings.Split(string(output), "\n")
+	cupaloy.SnapshotT(t, outputLines)
+}
+
+func Test118ParseErrorPosition(t *testing.T) {
+	input := `package test
+
+	// ENUM(a, b=oops1)
+	type Fine int
+
+	// ENUM(a, b=99999999999999999999)
+	type Broken int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "position.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.parseEnum(g.inspect(f)["Fine"])
+	require.NoError(t, err)
+
+	_, err = g.parseEnum(g.inspect(f)["Broken"])
+	require.EqualError(t, err, `position.go:7:7: failed parsing the data part of enum value 'b=99999999999999999999': strconv.ParseInt: parsing "99999999999999999999": value out of range`)
+}
+
+func Test118DanglingParen(t *testing.T) {
+	input := `package test
+
+	/*
+	ENUM(
+	a,
+	b (the second
+	)
+	*/
+	type Dangling int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "dangling.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.Generate(f)
+	require.EqualError(t, err, "generate: failed parsing 1 enum(s):\n\tdangling.go:9:7: ENUM parse error, there is a dangling '(' in your comment")
+}
+
+func Test118ShortCodes(t *testing.T) {
+	names := make([]string, 300)
+	for i := range names {
+		names[i] = "v" + strconv.Itoa(i)
+	}
+	input := "package test\n// ENUM(" + strings.Join(names, ", ") + ")\ntype Wide int\n"
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestShortCodes", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enum, err := g.parseEnum(g.inspect(f)["Wide"])
+	require.NoError(t, err)
+	codes := ShortCodes(*enum)
+	require.Len(t, codes, 300)
+	assert.Equal(t, "AA", codes[0].Code)
+	assert.Equal(t, "_w", codes[255].Code)
+	assert.Equal(t, "AQA", codes[256].Code)
+	assert.Equal(t, "ASs", codes[299].Code)
+}
+
+func Test118ParseErrors(t *testing.T) {
+	input := `package test
+	// ENUM(a, b=x1)
+	type Good int
+
+	// ENUM(a, a)
+	type Twice int
+
+	// ENUM(a, b=99999999999999999999)
+	type Overflow int
+	`
+
+	t.Run("strict", func(t *testing.T) {
+		g := NewGenerator()
+		f, err := parser.ParseFile(g.fileSet, "errors.go", input, parser.ParseComments)
+		require.NoError(t, err)
+
+		output, err := g.Generate(f)
+		assert.Nil(t, output)
+		require.EqualError(t, err, "generate: failed parsing 2 enum(s):\n"+
+			"\terrors.go:9:7: failed parsing the data part of enum value 'b=99999999999999999999': strconv.ParseInt: parsing \"99999999999999999999\": value out of range\n"+
+			"\terrors.go:6:7: enum \"Twice\" value \"a\" collides with \"a\", both are named TwiceA")
+	})
+
+	t.Run("lenient", func(t *testing.T) {
+		g := NewGenerator().
+			WithLenientParse()
+		f, err := parser.ParseFile(g.fileSet, "errors.go", input, parser.ParseComments)
+		require.NoError(t, err)
+
+		output, err := g.Generate(f)
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "func (x Good) String() string")
+		assert.NotContains(t, string(output), "Twice")
+		assert.NotContains(t, string(output), "Overflow")
+	})
+}
+
+func Test118Validify(t *testing.T) {
+	tests := map[string]struct {
+		decl     string
+		expected string
+	}{
+		"from zero": {
+			decl:     "ENUM(a, b, c)\ntype Status uint",
+			expected: "x <= StatusC",
+		},
+		"offset": {
+			decl:     "ENUM(a=3, b, c)\ntype Status int",
+			expected: "x >= StatusA && x <= StatusC",
+		},
+		"signed from zero": {
+			decl:     "ENUM(a, b)\ntype Status int",
+			expected: "x >= StatusA && x <= StatusB",
+		},
+		"out of order": {
+			decl:     "ENUM(c=2, a=0, b=1)\ntype Status int",
+			expected: "x >= StatusA && x <= StatusC",
+		},
+		"gap": {
+			decl: "ENUM(a, b, c=5)\ntype Status int",
+		},
+		"skipped": {
+			decl: "ENUM(a, _, c)\ntype Status int",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n// " + tc.decl + "\n"
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "TestValidify", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			enum, err := g.parseEnumSpec(g.inspect(f)["Status"])
+			require.NoError(t, err)
+			check, err := Validify(*enum)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, check)
+		})
+	}
+}
+
+func Test118RequireContiguous(t *testing.T) {
+	tests := map[string]struct {
+		decl         string
+		allowSkipped bool
+		err          string
+	}{
+		"contiguous": {
+			decl: "ENUM(a, b, c, d=3)",
+		},
+		"shared values": {
+			decl: "ENUM(\na\nb // canonical\nc=1\nd\n)",
+		},
+		"gap": {
+			decl: "ENUM(a, b, c=5)",
+			err:  `generate: enum "Indexed" is not contiguous, value 2 is missing`,
+		},
+		"not from zero": {
+			decl: "ENUM(a=1, b, c)",
+			err:  `generate: enum "Indexed" is not contiguous, value 0 is missing`,
+		},
+		"negative": {
+			decl: "ENUM(a=-1, b, c)",
+			err:  `generate: enum "Indexed" is not contiguous, value "a" is negative (-1)`,
+		},
+		"skipped allowed": {
+			decl:         "ENUM(a, _, c)",
+			allowSkipped: true,
+		},
+		"skipped is a gap": {
+			decl: "ENUM(a, _, c)",
+			err:  `generate: enum "Indexed" is not contiguous, value 1 is missing`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n/*\n" + tc.decl + "\n*/\ntype Indexed int\n"
+			g := NewGenerator().
+				WithRequireContiguous(tc.allowSkipped)
+			f, err := parser.ParseFile(g.fileSet, "TestRequireContiguous", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = g.Generate(f)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test118CSVResource(t *testing.T) {
+	tests := map[string]struct {
+		csv    string
+		header bool
+		values map[string]interface{}
+		err    string
+	}{
+		"values": {
+			csv:    "a,1\nb,\nc,10\n",
+			values: map[string]interface{}{"a": int64(1), "b": int64(2), "c": int64(10)},
+		},
+		"header": {
+			csv:    "name,value\na,5\nb,6\n",
+			header: true,
+			values: map[string]interface{}{"a": int64(5), "b": int64(6)},
+		},
+		"wrong field count": {
+			csv: "a,1\nb\n",
+			err: "failed reading the values of enum Coded: failed parsing %s: record on line 2: wrong number of fields",
+		},
+		"invalid name": {
+			csv:    "name,value\na,1\nb=2,3\n",
+			header: true,
+			err:    `failed reading the values of enum Coded: %s line 3: invalid name "b=2"`,
+		},
+		"missing file": {
+			err: "failed reading the values of enum Coded: open %s: no such file or directory",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			csvFile := filepath.Join(dir, "codes.csv")
+			if tc.csv != "" {
+				require.NoError(t, os.WriteFile(csvFile, []byte(tc.csv), 0o644))
+			}
+
+			input := "package test\n// ENUM(@csv:codes.csv)\ntype Coded int\n"
+			g := NewGenerator()
+			if tc.header {
+				g.WithCSVResourceHeader()
+			}
+			f, err := parser.ParseFile(g.fileSet, filepath.Join(dir, "coded.go"), input, parser.ParseComments)
+			require.NoError(t, err)
+
+			enum, err := g.parseEnumSpec(g.inspect(f)["Coded"])
+			if tc.err != "" {
+				require.EqualError(t, err, fmt.Sprintf(tc.err, csvFile))
+				return
+			}
+			require.NoError(t, err)
+			values := map[string]interface{}{}
+			for _, val := range enum.Values {
+				values[val.RawName] = val.Value
+			}
+			require.Equal(t, tc.values, values)
+		})
+	}
+}
+
+func Test118MethodsAllowlist(t *testing.T) {
+	input := `package test
+	// ENUM(pending, running, done)
+	type Status int
+	`
+	g := NewGenerator().
+		WithMarshal().
+		WithSQLDriver().
+		WithNames().
+		WithMustParse().
+		WithMethods("String", "Parse", "MarshalText")
+	f, err := parser.ParseFile(g.fileSet, "TestMethodsAllowlist", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "func (x Status) String() string")
+	assert.Contains(t, string(output), "func ParseStatus(name string)")
+	assert.Contains(t, string(output), "func (x Status) MarshalText()")
+	assert.NotContains(t, string(output), "func MustParseStatus(")
+	assert.NotContains(t, string(output), "func StatusNames()")
+	assert.NotContains(t, string(output), "UnmarshalText")
+	assert.NotContains(t, string(output), "Scan")
+	assert.NotContains(t, string(output), "IsValid")
+
+	outputLines := strings.Split(string(output), "\n")
+	cupaloy.SnapshotT(t, outputLines)
+}
+
+func Test118HTTPStatus(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	unknown
+	not_found // httpStatus=404
+	teapot // httpStatus=418
+	)
+	*/
+	type ErrorKind int
+	`
+	tests := map[string]struct {
+		fallback int
+		expected string
+	}{
+		"default":    {expected: "return 500"},
+		"configured": {fallback: 503, expected: "return 503"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator().
+				WithHTTPStatus(tc.fallback)
+			f, err := parser.ParseFile(g.fileSet, "TestHTTPStatus", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := g.Generate(f)
+			require.NoError(t, err)
+			assert.Contains(t, string(output), "var _ErrorKindHTTPStatuses = map[ErrorKind]int{\n\tErrorKindNotFound: 404,\n\tErrorKindTeapot:   418,\n}")
+			assert.Contains(t, string(output), tc.expected)
+		})
+	}
+
+	_, err := getHTTPStatusFromComment("httpStatus=42")
+	require.EqualError(t, err, "http status must be between 100 and 599, got 42")
+}
+
+func Test118StringTemplate(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	red
+	green // Go "green"
+	_
+	blue
+	)
+	*/
+	type Color int
+	`
+	g := NewGenerator().
+		WithMarshal().
+		WithStringTemplate(`{{.Name | title}} (#{{.Value}}){{with .Comment}}: {{.}}{{end}}`)
+	f, err := parser.ParseFile(g.fileSet, "TestStringTemplate", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `const _ColorName = "Red (#0)Green (#1): Go \"green\"Blue (#3)"`)
+
+	outputLines := strings.Split(string(output), "\n")
+	cupaloy.SnapshotT(t, outputLines)
+}
+
+func Test118StringTemplateErrors(t *testing.T) {
+	tests := map[string]struct {
+		tmpl string
+		err  string
+	}{
+		"invalid": {
+			tmpl: `{{.Name`,
+			err:  `failed parsing the string template: template: string:1: unclosed action`,
+		},
+		"unknown field": {
+			tmpl: `{{.Label}}`,
+			err:  `failed rendering the string of enum value 'red': template: string:1:2: executing "string" at <.Label>: can't evaluate field Label in type generator.StringTemplateData`,
+		},
+		"empty": {
+			tmpl: `{{if eq .Name "blue"}}blue{{end}}`,
+			err:  `the string template renders an empty string for enum value 'red'`,
+		},
+		"duplicate": {
+			tmpl: `{{.Enum}}`,
+			err:  `the string template renders "Color" for both enum values 'red' and 'blue'`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n// ENUM(red, blue)\ntype Color int\n"
+			g := NewGenerator().
+				WithStringTemplate(tc.tmpl)
+			f, err := parser.ParseFile(g.fileSet, "TestStringTemplateErrors", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = g.parseEnumSpec(g.inspect(f)["Color"])
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func Test118OrdinalConstants(t *testing.T) {
+	input := `package test
+	// ENUM(north=10, _, east, south=50, west)
+	type Heading int
+	`
+	g := NewGenerator().
+		WithOrdinalConstants()
+	f, err := parser.ParseFile(g.fileSet, "TestOrdinalConstants", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "HeadingNorthOrdinal = 0\n")
+	assert.Contains(t, string(output), "HeadingEastOrdinal = 1\n")
+	assert.Contains(t, string(output), "HeadingSouthOrdinal = 2\n")
+	assert.Contains(t, string(output), "HeadingWestOrdinal = 3\n")
+
+	outputLines := strings.Split(string(output), "\n")
+	cupaloy.SnapshotT(t, outputLines)
+}
+
+func Test118TypeCheck(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "broken.tmpl")
+	require.NoError(t, os.WriteFile(filename, []byte(`
+// Broken returns a field the enum doesn't have.
+func (x {{.enum.Name}}) Broken() int {
+	return x.Missing
+}
+`), 0o644))
+
+	input := `package test
+	// ENUM(pending, done)
+	type Status int
+	`
+	tests := map[string]struct {
+		templates []string
+		err       string
+	}{
+		"valid": {},
+		"broken template": {
+			templates: []string{filename},
+			err:       "generate: generated code does not type check:\nTestTypeCheck_enum.go:70:11: x.Missing undefined (type Status has no field or method Missing)",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator().
+				WithMarshal().
+				WithTypeCheck()
+			if len(tc.templates) > 0 {
+				require.NoError(t, g.WithTemplates(tc.templates...))
+			}
+			f, err := parser.ParseFile(g.fileSet, "TestTypeCheck", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = g.Generate(f)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test118PreserveCustomRegion(t *testing.T) {
+	const region = `// go-enum:custom
+
+// Shout returns the name of the Status in upper case.
+func (x Status) Shout() string {
+	return strings.ToUpper(x.String())
+}
+
+// Timeout returns how long a Status is kept.
+func (x Status) Timeout() time.Duration {
+	return time.Minute
+}
+`
+	input := `package test
+	// ENUM(pending, done)
+	type Status int
+	`
+	tests := map[string]struct {
+		existing string
+		preserve bool
+		kept     bool
+	}{
+		"custom region": {
+			existing: "// Code generated by go-enum DO NOT EDIT.\n\npackage test\n\nimport (\n\t\"fmt\"\n\t\"strings\"\n\t\"time\"\n)\n\nconst _StatusName = \"old\"\n\n" + region,
+			preserve: true,
+			kept:     true,
+		},
+		"disabled": {
+			existing: "package test\n\nimport (\n\t\"strings\"\n\t\"time\"\n)\n\n" + region,
+		},
+		"no marker": {
+			existing: "package test\n\n// Extra was added by hand.\nconst Extra = 1\n",
+			preserve: true,
+		},
+		"no existing file": {
+			preserve: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			outputFile := filepath.Join(t.TempDir(), "status_enum.go")
+			if tc.existing != "" {
+				require.NoError(t, os.WriteFile(outputFile, []byte(tc.existing), 0o644))
+			}
+			g := NewGenerator()
+			if tc.preserve {
+				g.WithPreserveCustomRegion()
+			}
+			f, err := parser.ParseFile(g.fileSet, "TestPreserveCustomRegion", input, parser.ParseComments)
+			require.NoError(t, err)
+			generated, err := g.Generate(f)
+			require.NoError(t, err)
+
+			output, err := g.MergeCustomRegion(outputFile, generated)
+			require.NoError(t, err)
+			if !tc.kept {
+				require.Equal(t, string(generated), string(output))
+				return
+			}
+			assert.Contains(t, string(output), "const _StatusName = \"pendingdone\"")
+			assert.NotContains(t, string(output), "\"old\"")
+			assert.True(t, strings.HasSuffix(string(output), "\n\n"+region), "the custom region ends the file")
+			assert.Contains(t, string(output), "\t\"strings\"\n\t\"time\"\n")
+
+			// Regenerating again keeps the region exactly once.
+			require.NoError(t, os.WriteFile(outputFile, output, 0o644))
+			again, err := g.MergeCustomRegion(outputFile, generated)
+			require.NoError(t, err)
+			assert.Equal(t, string(output), string(again))
+		})
+	}
+}
+
+func Test118YAMLFormats(t *testing.T) {
+	input := `package test
+	// ENUM(pending, done) formats=json
+	type JSONOnly int
+
+	// ENUM(low, high) formats=yaml
+	type YAMLOnly int
+
+	// ENUM(on, off)
+	type Global int
+	`
+	g := NewGenerator().
+		WithYAML()
+	f, err := parser.ParseFile(g.fileSet, "TestYAMLFormats", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.NotContains(t, string(output), "func (x JSONOnly) MarshalYAML()")
+	assert.Contains(t, string(output), "func (x YAMLOnly) MarshalYAML()")
+	assert.Contains(t, string(output), "func (x *YAMLOnly) UnmarshalYAML(")
+	assert.Contains(t, string(output), "func (x YAMLOnly) MarshalText()")
+	assert.Contains(t, string(output), "func (x Global) MarshalYAML()")
+	assert.NotContains(t, string(output), "func (x Global) MarshalText()")
+}
+
+func Test118MaxLen(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	ok // canonical
+	Straße
+	longer_name
+	an_alias_never_returned=0
+	)
+	*/
+	type Status int
+	`
+	tests := map[string]struct {
+		g        *Generator
+		expected string
+	}{
+		"default": {
+			g:        NewGenerator().WithMaxLen(),
+			expected: "const StatusMaxNameLen = 11\n",
+		},
+		"force lower": {
+			g:        NewGenerator().WithMaxLen().WithForceLower(),
+			expected: "const StatusMaxNameLen = 11\n",
+		},
+		"prefixed strings": {
+			g:        NewGenerator().WithMaxLen().WithPrefixedStrings(),
+			expected: "const StatusMaxNameLen = 16\n",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := parser.ParseFile(tc.g.fileSet, "TestMaxLen", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := tc.g.Generate(f)
+			require.NoError(t, err)
+			assert.Contains(t, string(output), tc.expected)
+
+			outputLines := strings.Split(string(output), "\n")
+			cupaloy.SnapshotT(t, outputLines)
+		})
+	}
+}
+
+func Test118Pattern(t *testing.T) {
+	input := `package test
+	// ENUM(Go, c++, node.js, f#)
+	type Language int
+	`
+	g := NewGenerator().
+		WithPattern().
+		WithForceLower()
+	f, err := parser.ParseFile(g.fileSet, "TestPattern", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	const quoted = `"^(go|c\\+\\+|node\\.js|f#)$"`
+	assert.Contains(t, string(output), "return "+quoted)
+
+	unquoted, err := strconv.Unquote(quoted)
+	require.NoError(t, err)
+	pattern := regexp.MustCompile(unquoted)
+	for _, name := range []string{"go", "c++", "node.js", "f#"} {
+		assert.True(t, pattern.MatchString(name), name)
+	}
+	for _, name := range []string{"Go", "c", "nodexjs", "go|c++"} {
+		assert.False(t, pattern.MatchString(name), name)
+	}
+}
+
+func Test118TypeDocPreserved(t *testing.T) {
+	input := `package test
+	// Shape is an enumeration of shapes.
+	//
+	// Shapes are drawn in declaration order.
+	/*
+	ENUM(
+	circle // Round
+	square
+	) formats=json step=2
+	*/
+	// Anything after the declaration is kept too.
+	type Shape int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestTypeDocPreserved", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `// Shape is an enumeration of shapes.
+//
+// Shapes are drawn in declaration order.
+//
+// Anything after the declaration is kept too.
+const (
+	// ShapeCircle is a Shape of type Circle.
+	// Round
+	ShapeCircle Shape = iota
+`)
+}
+
+func Test118DirectivesOutsideDeclaration(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	low // step=5 between levels
+	high
+	)
+	*/
+	type Level int
+
+	/*
+	ENUM(
+	slow // formats=yaml
+	fast
+	) step=10 xmlName=speed
+	*/
+	type Speed int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestDirectivesOutsideDeclaration", input, parser.ParseComments)
+	require.NoError(t, err)
+	enums := g.inspect(f)
+
+	level, err := g.parseEnumSpec(enums["Level"])
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(0), int64(1)}, []interface{}{level.Values[0].Value, level.Values[1].Value}, "a step in a value comment is not the step of the enum")
+	assert.Equal(t, "step=5 between levels", level.Values[0].Comment)
+
+	speed, err := g.parseEnumSpec(enums["Speed"])
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(0), int64(10)}, []interface{}{speed.Values[0].Value, speed.Values[1].Value})
+	assert.Empty(t, speed.Formats)
+	assert.Equal(t, "speed", speed.XMLName)
+}
+
+func Test118JSONZeroRepr(t *testing.T) {
+	input := `package test
+	// ENUM(none, some)
+	type Amount int
+	`
+	tests := map[string]struct {
+		repr     string
+		expected string
+		err      string
+	}{
+		"empty string": {repr: `""`, expected: `return []byte("\"\""), nil`},
+		"compacted":    {repr: `{ "kind": "none" }`, expected: `return []byte("{\"kind\":\"none\"}"), nil`},
+		"invalid":      {repr: `none`, err: `generate: the json zero value representation "none" is not valid json: invalid character 'o' in literal null (expecting 'u')`},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator().
+				WithJSONZeroRepr(tc.repr)
+			f, err := parser.ParseFile(g.fileSet, "TestJSONZeroRepr", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := g.Generate(f)
+			if tc.err != "" {
+				require.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(output), tc.expected)
+		})
+	}
+}
+
+func Test118StringEnum(t *testing.T) {
+	input := `package test
+	// ENUM(Draft, In_Review = "in-review", _, Published)
+	type State string
+	`
+	g := NewGenerator().
+		WithForceLower()
+	f, err := parser.ParseFile(g.fileSet, "TestStringEnum", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enum, err := g.parseEnumSpec(g.inspect(f)["State"])
+	require.NoError(t, err)
+	values := map[string]interface{}{}
+	for _, val := range enum.Values {
+		if val.Name != skipHolder {
+			values[val.PrefixedName] = val.Value
+		}
+	}
+	require.Equal(t, map[string]interface{}{"StateDraft": "draft", "StateInReview": "in-review", "StatePublished": "published"}, values)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "\tStateInReview State = \"in-review\"\n")
+	assert.NotContains(t, string(output), "iota")
+
+	outputLines := strings.Split(string(output), "\n")
+	cupaloy.SnapshotT(t, outputLines)
+}
+
+func Test118ExplicitStringValues(t *testing.T) {
+	input := `package test
+	// ENUM(Pending=pending, Numbered=5, Quoted="10", Next, Negative=-2)
+	type State int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestExplicitStringValues", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enum, err := g.parseEnumSpec(g.inspect(f)["State"])
+	require.NoError(t, err)
+	type value struct {
+		RawName     string
+		StringValue string
+		Value       interface{}
+	}
+	var values []value
+	for _, val := range enum.Values {
+		values = append(values, value{RawName: val.RawName, StringValue: val.StringValue, Value: val.Value})
+	}
+	require.Equal(t, []value{
+		{RawName: "pending", StringValue: "pending", Value: int64(0)},
+		{RawName: "Numbered", Value: int64(5)},
+		{RawName: "10", StringValue: "10", Value: int64(6)},
+		{RawName: "Next", Value: int64(7)},
+		{RawName: "Negative", Value: int64(-2)},
+	}, values)
+}
+
+func Test118FuzzyParseCollision(t *testing.T) {
+	input := `package test
+	// ENUM(ab, a_b)
+	type Work int
+	`
+	g := NewGenerator().WithFuzzyParse()
+	f, err := parser.ParseFile(g.fileSet, "TestFuzzyParseCollision", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, `generate: enum "Work" values "ab" and "a_b" can not be told apart by the fuzzy parse`)
+
+	_, err = NewGenerator().Generate(f)
+	assert.NoError(t, err, "the names only collide for the fuzzy parse")
+}
+
+func Test118SQLDual(t *testing.T) {
+	input := `package test
+	// ENUM(a, b)
+	type Animal int
+	`
+	tests := map[string]struct {
+		generator *Generator
+		err       string
+	}{
+		"string":         {generator: NewGenerator().WithSQLDual("string")},
+		"int":            {generator: NewGenerator().WithSQLDual("int")},
+		"representation": {generator: NewGenerator().WithSQLDual("bytes"), err: `generate: the sql dual value representation must be either string or int, not "bytes"`},
+		"sql":            {generator: NewGenerator().WithSQLDual("int").WithSQLDriver(), err: `generate: enum "Animal" can not have both the sql dual and the other sql methods, as both generate a Scan method`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := parser.ParseFile(tc.generator.fileSet, "TestSQLDual", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := tc.generator.Generate(f)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, string(output), "func (x *Animal) Scan(value interface{}) (err error) {")
+			assert.Contains(t, string(output), "writing the Animal as its "+map[string]string{"string": "name", "int": "integer value"}[name])
+		})
+	}
+}
+
+func Test118Binary(t *testing.T) {
+	for enumType, bits := range map[string]int{"int": 64, "uint": 64, "int8": 8, "uint16": 16, "int32": 32, "uint64": 64} {
+		assert.Equal(t, bits, BinaryBits(enumType), enumType)
+	}
+
+	input := `package test
+	// ENUM(a, b)
+	type Letter string
+	`
+	g := NewGenerator().WithBinary()
+	f, err := parser.ParseFile(g.fileSet, "TestBinary", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.Generate(f)
+	assert.EqualError(t, err, `generate: enum "Letter" is a string enum, which has no integer value to encode as binary`)
+}
+
+func Test118Transitions(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	draft // next=review
+	review // next=draft,published
+	published // canonical
+	live = 2
+	)
+	*/
+	type Doc int
+
+	/*
+	ENUM(
+	draft // next=archived
+	)
+	*/
+	type Broken int
+	`
+	g := NewGenerator().WithTransitions()
+	f, err := parser.ParseFile(g.fileSet, "TestTransitions", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enums := g.inspect(f)
+	enum, err := g.parseEnumSpec(enums["Doc"])
+	require.NoError(t, err)
+	transitions, err := Transitify(*enum)
+	require.NoError(t, err)
+	assert.Equal(t, "map[Doc][]Doc{\nDocDraft: {DocReview},\nDocReview: {DocDraft, DocPublished},\n}", transitions)
+
+	_, err = g.parseEnumSpec(enums["Broken"])
+	assert.EqualError(t, err, `enum "Broken" value "draft" transitions to "archived", which is not a value of the enum`)
+}
+
+func Test118GenerateFromJSONSchemaEnum(t *testing.T) {
+	t.Run("same as declared", func(t *testing.T) {
+		input := `package test
+		// ENUM(pending, in_progress, done)
+		type JobState string
+		`
+		g := NewGenerator().WithMarshal()
+		f, err := parser.ParseFile(g.fileSet, "TestGenerateFromJSONSchemaEnum", input, parser.ParseComments)
+		require.NoError(t, err)
+		declared, err := g.Generate(f)
+		require.NoError(t, err)
+
+		output, err := NewGenerator().WithMarshal().GenerateFromJSONSchemaEnum("test", "JobState", []string{"pending", "in_progress", "done"})
+		require.NoError(t, err)
+		typeDecl := "// JobState is generated from a schema enum.\ntype JobState string\n\n"
+		assert.Contains(t, string(output), typeDecl)
+		assert.Equal(t, string(declared), strings.Replace(string(output), typeDecl, "", 1))
+	})
+
+	t.Run("verbatim values", func(t *testing.T) {
+		output, err := NewGenerator().GenerateFromJSONSchemaEnum("schema", "Shipping", []string{"next-day", "2 days", "pick up (store)"})
+		require.NoError(t, err)
+		assert.Contains(t, string(output), "package schema\n")
+		assert.Contains(t, string(output), "type Shipping string")
+
+		outputLines := strings.Split(string(output), "\n")
+		cupaloy.SnapshotT(t, outputLines)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := NewGenerator().GenerateFromJSONSchemaEnum("schema", "Empty", nil)
+		assert.EqualError(t, err, `generate: schema enum "Empty" has no values`)
+
+		_, err = NewGenerator().GenerateFromJSONSchemaEnum("schema", "Twice", []string{"a_b", "a-b"})
+		assert.Error(t, err)
+	})
+}
+
+func Test118NegativeValues(t *testing.T) {
+	input := `package test
+	// ENUM(Unknown=-1, Zero=0, One=1)
+	type Explicit int
+
+	// ENUM(a=-3, b, c, d, e)
+	type Increment int8
+
+	// ENUM(Unknown=-1, Zero)
+	type Unsigned uint
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestNegativeValues", input, parser.ParseComments)
+	require.NoError(t, err)
+	enums := g.inspect(f)
+
+	values := func(enum *Enum) []interface{} {
+		var ret []interface{}
+		for _, val := range enum.Values {
+			ret = append(ret, val.Value)
+		}
+		return ret
+	}
+
+	enum, err := g.parseEnumSpec(enums["Explicit"])
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(-1), int64(0), int64(1)}, values(enum))
+
+	enum, err = g.parseEnumSpec(enums["Increment"])
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(-3), int64(-2), int64(-1), int64(0), int64(1)}, values(enum))
+
+	_, err = g.parseEnumSpec(enums["Unsigned"])
+	assert.EqualError(t, err, "enum value 'Unknown=-1' is negative, which the unsigned type uint of enum Unsigned can not hold")
+}
+
+func Test118UnsignedTypes(t *testing.T) {
+	tests := map[string]interface{}{
+		"int":     int64(1),
+		"int8":    int64(1),
+		"int16":   int64(1),
+		"int32":   int64(1),
+		"int64":   int64(1),
+		"rune":    int64(1),
+		"uint":    uint64(1),
+		"uint8":   uint64(1),
+		"uint16":  uint64(1),
+		"uint32":  uint64(1),
+		"uint64":  uint64(1),
+		"uintptr": uint64(1),
+		"byte":    uint64(1),
+		"ufixed":  int64(1),
+	}
+
+	for enumType, expected := range tests {
+		t.Run(enumType, func(t *testing.T) {
+			input := "package test\n// ENUM(a=1)\ntype Base " + enumType + "\n"
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "TestUnsignedTypes", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			enum, err := g.parseEnumSpec(g.inspect(f)["Base"])
+			require.NoError(t, err)
+			assert.Equal(t, expected, enum.Values[0].Value)
+			_, unsigned := expected.(uint64)
+			assert.Equal(t, unsigned, IsUnsigned(enumType))
+		})
+	}
+}
+
+func Test118PrefixedLiterals(t *testing.T) {
+	input := `package test
+	// ENUM(A=0x01, B=0x02, C=0x10, D, E=0b0100_0000, F=0o17, G=1_000, H=12)
+	type Register uint16
+
+	// ENUM(neg=-0x10, next, big=0X7f)
+	type Signed int
+
+	// ENUM(a=0x, b)
+	type Broken int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestPrefixedLiterals", input, parser.ParseComments)
+	require.NoError(t, err)
+	enums := g.inspect(f)
+
+	values := func(enum *Enum) []interface{} {
+		var ret []interface{}
+		for _, val := range enum.Values {
+			ret = append(ret, val.Value)
+		}
+		return ret
+	}
+
+	enum, err := g.parseEnumSpec(enums["Register"])
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{uint64(1), uint64(2), uint64(16), uint64(17), uint64(64), uint64(15), uint64(1000), uint64(12)}, values(enum))
+
+	enum, err = g.parseEnumSpec(enums["Signed"])
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(-16), int64(-15), int64(127)}, values(enum))
+
+	_, err = g.parseEnumSpec(enums["Broken"])
+	assert.EqualError(t, err, `failed parsing the data part of enum value 'a=0x': strconv.ParseInt: parsing "0x": invalid syntax`)
+}
+
+func Test118SourceOrder(t *testing.T) {
+	input := `package test
+	var fallback = Mango(0)
+
+	// ENUM(z)
+	type Zebra int
+
+	// ENUM(a)
+	type Apple int
+
+	// ENUM(m)
+	type Mango int
+	`
+	tests := map[string]struct {
+		generator *Generator
+		expected  []string
+	}{
+		"alphabetical": {generator: NewGenerator(), expected: []string{"AppleA", "MangoM", "ZebraZ"}},
+		"source":       {generator: NewGenerator().WithSourceOrder(), expected: []string{"ZebraZ", "AppleA", "MangoM"}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := parser.ParseFile(tc.generator.fileSet, "TestSourceOrder", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := tc.generator.Generate(f)
+			require.NoError(t, err)
+			var positions []int
+			for _, constant := range tc.expected {
+				positions = append(positions, strings.Index(string(output), "\t"+constant+" "))
+			}
+			assert.True(t, sort.IntsAreSorted(positions), "%v in %v", tc.expected, positions)
+			assert.NotContains(t, positions, -1)
+		})
+	}
+}
+
+func Test118CommentCommas(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected map[string]string
+	}{
+		"single line": {
+			input:    "// ENUM(A // one, two, three: four)",
+			expected: map[string]string{"A": "one, two, three: four"},
+		},
+		"single line parens": {
+			input:    "// ENUM(A, B // one, two (or three): four)",
+			expected: map[string]string{"A": "", "B": "one, two (or three): four"},
+		},
+		"multi line": {
+			input:    "/*\nENUM(\nA // one, two: three\nB, C // four, five, six\nD // seven (eight, nine): ten)\n*/",
+			expected: map[string]string{"A": "one, two: three", "B": "", "C": "four, five, six", "D": "seven (eight, nine): ten"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			input := "package test\n" + tc.input + "\ntype Commented int\n"
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "TestCommentCommas", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			enum, err := g.parseEnumSpec(g.inspect(f)["Commented"])
+			require.NoError(t, err)
+			comments := map[string]string{}
+			for _, val := range enum.Values {
+				comments[val.RawName] = val.Comment
+			}
+			assert.Equal(t, tc.expected, comments)
+		})
+	}
+}
+
+func Test118Lowerify(t *testing.T) {
+	input := `package test
+	// ENUM(Beta, alpha, BETA, _, Gamma)
+	type Cased int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestLowerify", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enum, err := g.parseEnumSpec(g.inspect(f)["Cased"])
+	require.NoError(t, err)
+	lookup, err := Lowerify(*enum)
+	require.NoError(t, err)
+	assert.Equal(t, "map[string]Cased{\n\"alpha\": CasedAlpha,\n\"beta\": CasedBeta,\n\"gamma\": CasedGamma,\n}", lookup, "the first declared name wins")
+}
+
+func Test118StringEnumUnsupportedOptions(t *testing.T) {
+	input := `package test
+	// ENUM(usd, eur)
+	type Currency string
+	`
+	tests := map[string]struct {
+		generator *Generator
+		err       string
+	}{
+		"supported": {generator: NewGenerator().WithMarshal().WithYAML().WithXML().WithTOML().WithValues().WithValidate()},
+		"walk":      {generator: NewGenerator().WithWalk(), err: `generate: enum "Currency" is a string enum, which does not support the walk option(s)`},
+		"several":   {generator: NewGenerator().WithSQLNullStr().WithJSONZeroRepr(`""`).WithMarshal(), err: `generate: enum "Currency" is a string enum, which does not support the jsonzerorepr, sqlnullstr option(s)`},
+		"bson":      {generator: NewGenerator().WithBSON(), err: `generate: enum "Currency" is a string enum, which does not support the bson option(s)`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := parser.ParseFile(tc.generator.fileSet, "TestStringEnumUnsupportedOptions", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = tc.generator.Generate(f)
+			if tc.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.err)
+
+			_, err = tc.generator.GenerateFromJSONSchemaEnum("test", "Currency", []string{"usd", "eur"})
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func Test118CanonicalWordInDescription(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	usd // The canonical currency of the store
+	dollar=0 // canonical
+	eur // Not the canonical one
+	)
+	*/
+	type Currency int
+	`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "TestCanonicalWordInDescription", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enum, err := g.parseEnumSpec(g.inspect(f)["Currency"])
+	require.NoError(t, err)
+	assert.False(t, enum.Values[0].Canonical, "the word in a description is not the directive")
+	assert.True(t, enum.Values[1].Canonical)
+	assert.False(t, enum.Values[2].Canonical)
+
+	assert.Equal(t, "The canonical currency of the store", descriptionFromComment(enum.Values[0].Comment))
+	assert.Equal(t, "", descriptionFromComment(enum.Values[1].Comment))
+	assert.Equal(t, "Not the canonical one", descriptionFromComment(enum.Values[2].Comment))
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "CurrencyDollar: _CurrencyName[3:9],", "String returns the name marked canonical")
+}
+
+func Test118Describify(t *testing.T) {
+	input := `package test
+	/*
+	ENUM(
+	basic // weight=1 category=cheap Only the essentials
+	plus // Most popular next=basic,premium
+	premium // canonical alias:billing=PREM Everything included
+	gold = 2 // hex=#FFD700
+	legacy // Deprecated: use premium
+	)
+	*/
+	type Tier int
+	`
+	g := NewGenerator().WithWeights().WithCategories().WithTransitions().WithSystemAliases()
+	f, err := parser.ParseFile(g.fileSet, "TestDescribify", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enum, err := g.parseEnumSpec(g.inspect(f)["Tier"])
+	require.NoError(t, err)
+	descriptions, err := Describify(*enum)
+	require.NoError(t, err)
+	assert.Equal(t, "map[Tier]string{\n"+
+		"TierBasic: \"Only the essentials\",\n"+
+		"TierPlus: \"Most popular\",\n"+
+		"TierPremium: \"Everything included\",\n"+
+		"TierLegacy: \"use premium\",\n"+
+		"}", descriptions, "directives are not part of the description, and a value with nothing else has none")
+}
+
+func Test118EmptyAsUnknownValue(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		err   string
+	}{
+		"misspelled": {
+			input: "// ENUM(unspecified, small, large)\ntype ShirtSize int\n",
+			err:   `generate: "unspecifed", the value to parse empty strings as, is not a value of "ShirtSize"`,
+		},
+		"no enum has it": {
+			input: "// ENUM(unspecified, small, large)\ntype ShirtSize int\n\n// ENUM(red, green)\ntype Color int\n",
+			err:   `generate: "unspecifed", the value to parse empty strings as, is not a value of "Color", "ShirtSize"`,
+		},
+		"one enum has it": {
+			input: "// ENUM(unspecifed, small, large)\ntype ShirtSize int\n\n// ENUM(red, green)\ntype Color int\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator().WithEmptyAs("unspecifed")
+			f, err := parser.ParseFile(g.fileSet, "TestEmptyAsUnknownValue", "package test\n\n"+tc.input, parser.ParseComments)
+			require.NoError(t, err)
+
+			_, err = g.Generate(f)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tc.err)
+		})
+	}
+
+	_, err := NewGenerator().WithEmptyAs("unspecifed").GenerateFromJSONSchemaEnum("test", "ShirtSize", []string{"unspecified", "small"})
+	require.EqualError(t, err, `generate: "unspecifed", the value to parse empty strings as, is not a value of "ShirtSize"`)
+}
@@ -76,8 +76,9 @@ func TestExampleFileMoreOptions(t *testing.T) {
 		WithNames().
 		WithoutSnakeToCamel().
 		WithMustParse().
-		WithForceLower().
-		WithTemplates(`../example/user_template.tmpl`)
+		WithForceLower()
+	err := g.WithTemplates(`../example/user_template.tmpl`)
+	require.NoError(t, err)
 	// Parse the file given in arguments
 	imported, err := g.GenerateFromFile(testExample)
 	require.Nil(t, err, "Error generating formatted code")
@@ -0,0 +1,223 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/emicklei/proto"
+)
+
+// protoFile holds the parts of a .proto file needed to generate enums from it.
+type protoFile struct {
+	pkg       string
+	goPackage string
+	enums     []protoEnum
+}
+
+// protoEnum holds an enum definition found in a .proto file.
+type protoEnum struct {
+	name       string
+	localName  string
+	protoName  string
+	values     []protoEnumValue
+	allowAlias bool
+}
+
+// fullName returns the fully qualified proto name of the enum, e.g. `acme.v1.Parcel.Size`.
+func (pe protoEnum) fullName(pkg string) string {
+	if pkg == "" {
+		return pe.protoName
+	}
+	return pkg + "." + pe.protoName
+}
+
+// protoEnumValue holds a single value of a proto enum definition.
+type protoEnumValue struct {
+	name   string
+	number int64
+}
+
+// GenerateFromProto generates enums for the enum definitions of a .proto file, keeping the proto value numbers.
+// UPPER_SNAKE value names become CamelCase constants, while String() keeps returning the original proto name.
+func (g *Generator) GenerateFromProto(protoPath string) ([]byte, error) {
+	content, err := ioutil.ReadFile(protoPath)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error reading proto file '%s': %s", protoPath, err)
+	}
+
+	file, err := parseProto(protoPath, string(content))
+	if err != nil {
+		return nil, fmt.Errorf("generate: error parsing proto file: %s", err)
+	}
+	if len(file.enums) <= 0 {
+		return nil, nil
+	}
+
+	if err := g.prepareUserTemplates(protoPath); err != nil {
+		return nil, err
+	}
+
+	pkg := file.goPackageName()
+
+	vBuff := bytes.NewBuffer([]byte{})
+	if err := g.writeHeader(vBuff, pkg); err != nil {
+		return nil, err
+	}
+
+	// Make the output more consistent by iterating over the enums sorted by name
+	sort.Slice(file.enums, func(i, j int) bool { return file.enums[i].name < file.enums[j].name })
+
+	var generated []*Enum
+	for _, pe := range file.enums {
+		enum := g.protoToEnum(pe)
+		// The same checks as for enums declared in go, a proto enum may well use names that end up colliding in go.
+		if err := validateUniqueNames(enum); err != nil {
+			return nil, fmt.Errorf("generate: %s", err)
+		}
+		if err := g.validateEnum(enum); err != nil {
+			return nil, err
+		}
+		// Unlike enums declared in go, there is no type declaration for the enum to go with the generated code.
+		fmt.Fprintf(vBuff, "\n// %s is generated from the %s proto enum.\ntype %s %s\n", enum.Name, pe.fullName(file.pkg), enum.Name, enum.Type)
+		if err := g.writeEnum(vBuff, enum.Name, enum); err != nil {
+			return vBuff.Bytes(), err
+		}
+		generated = append(generated, enum)
+	}
+	if err := g.validateEmptyAs(generated); err != nil {
+		return nil, err
+	}
+
+	formatted, err := formatOutput(pkg, vBuff)
+	if err != nil || !g.typeCheck {
+		return formatted, err
+	}
+	return formatted, g.checkGeneratedTypes(pkg, protoPath, formatted)
+}
+
+// goPackageName returns the package name for the generated code, preferring the go_package option over the proto package.
+func (f protoFile) goPackageName() string {
+	if f.goPackage != "" {
+		if semi := strings.LastIndex(f.goPackage, ";"); semi >= 0 {
+			return f.goPackage[semi+1:]
+		}
+		return path.Base(f.goPackage)
+	}
+	if f.pkg != "" {
+		return f.pkg[strings.LastIndex(f.pkg, ".")+1:]
+	}
+	return "main"
+}
+
+// protoToEnum converts a proto enum definition into the Enum used by the templates.
+func (g *Generator) protoToEnum(pe protoEnum) *Enum {
+	enum := &Enum{
+		Name: pe.name,
+		Type: "int32",
+	}
+	if !g.noPrefix {
+		enum.Prefix = pe.name
+	}
+	if g.prefix != "" {
+		enum.Prefix = g.prefix + enum.Prefix
+	}
+
+	// Proto value names are conventionally prefixed with the enum name, which would be repeated in the constant names.
+	valuePrefix := upperSnakeCase(pe.localName) + "_"
+	// With allow_alias, the first name declared for a value is the one protoc uses as its string form.
+	seen := map[int64]bool{}
+	for _, pv := range pe.values {
+		name := protoValueName(strings.TrimPrefix(pv.name, valuePrefix))
+		enum.Values = append(enum.Values, EnumValue{
+			RawName:      pv.name,
+			Name:         name,
+			PrefixedName: sanitizeValue(enum.Prefix+name, g.numericPrefix, g.replacementNames),
+			Value:        pv.number,
+			Weight:       defaultWeight,
+			Canonical:    pe.allowAlias && !seen[pv.number],
+		})
+		seen[pv.number] = true
+	}
+	return enum
+}
+
+// protoValueName converts an UPPER_SNAKE proto value name to CamelCase.
+func protoValueName(name string) string {
+	parts := strings.Split(strings.ToLower(name), "_")
+	for i, part := range parts {
+		if r, size := utf8.DecodeRuneInString(part); size > 0 {
+			parts[i] = string(unicode.ToUpper(r)) + part[size:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// upperSnakeCase converts a CamelCase name to the UPPER_SNAKE form proto value names are prefixed with.
+func upperSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && !unicode.IsUpper(runes[i-1]) {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+// parseProto finds the package, go_package option and (nested) enum definitions of a .proto file.
+// Errors start with the filename and the position in the file.
+func parseProto(filename, content string) (protoFile, error) {
+	var file protoFile
+	parser := proto.NewParser(strings.NewReader(content))
+	parser.Filename(filename)
+	def, err := parser.Parse()
+	if err != nil {
+		return file, err
+	}
+	proto.Walk(def,
+		proto.WithPackage(func(p *proto.Package) {
+			file.pkg = p.Name
+		}),
+		proto.WithOption(func(o *proto.Option) {
+			if _, ok := o.Parent.(*proto.Proto); ok && o.Name == "go_package" {
+				file.goPackage = o.Constant.Source
+			}
+		}),
+		proto.WithEnum(func(e *proto.Enum) {
+			file.enums = append(file.enums, newProtoEnum(e))
+		}),
+	)
+	return file, nil
+}
+
+// newProtoEnum converts a parsed enum definition, naming nested enums after the messages holding them.
+func newProtoEnum(e *proto.Enum) protoEnum {
+	pe := protoEnum{name: e.Name, localName: e.Name, protoName: e.Name}
+	for parent := e.Parent; parent != nil; {
+		msg, ok := parent.(*proto.Message)
+		if !ok {
+			break
+		}
+		pe.name = msg.Name + pe.name
+		pe.protoName = msg.Name + "." + pe.protoName
+		parent = msg.Parent
+	}
+	for _, element := range e.Elements {
+		switch el := element.(type) {
+		case *proto.EnumField:
+			pe.values = append(pe.values, protoEnumValue{name: el.Name, number: int64(el.Integer)})
+		case *proto.Option:
+			if el.Name == "allow_alias" && el.Constant.Source == "true" {
+				pe.allowAlias = true
+			}
+		}
+	}
+	return pe
+}
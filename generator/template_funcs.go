@@ -1,7 +1,12 @@
 package generator
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -20,15 +25,339 @@ func Stringify(e Enum, forceLower bool) (ret string, err error) {
 	return
 }
 
-// Mapify returns a map that is all of the indexes for a string value lookup
+// Canonicals returns the enum values without skipped values, keeping one value for each set of names sharing a value.
+// The value marked canonical is kept, or else the first one declared.
+func Canonicals(e Enum) []EnumValue {
+	names := map[interface{}]string{}
+	for _, val := range e.Values {
+		if _, ok := names[val.Value]; val.Name != skipHolder && (!ok || val.Canonical) {
+			names[val.Value] = val.PrefixedName
+		}
+	}
+	var ret []EnumValue
+	for _, val := range e.Values {
+		if val.Name != skipHolder && names[val.Value] == val.PrefixedName {
+			ret = append(ret, val)
+		}
+	}
+	return ret
+}
+
+// Deprecations returns the deprecated enum values, keeping one value for each set of names sharing a value
+func Deprecations(e Enum) []EnumValue {
+	seen := map[interface{}]bool{}
+	var ret []EnumValue
+	for _, val := range e.Values {
+		if val.Name != skipHolder && val.Deprecated && !seen[val.Value] {
+			seen[val.Value] = true
+			ret = append(ret, val)
+		}
+	}
+	return ret
+}
+
+// Categorify returns a map of each enum value to the set of categories of all of its names
+func Categorify(e Enum) (ret string, err error) {
+	ret = fmt.Sprintf("map[%s]map[string]bool{\n", e.Name)
+	categories := map[interface{}][]string{}
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			categories[val.Value] = append(categories[val.Value], val.Categories...)
+		}
+	}
+	for _, val := range Canonicals(e) {
+		if len(categories[val.Value]) == 0 {
+			continue
+		}
+		ret = fmt.Sprintf("%s%s: {", ret, val.PrefixedName)
+		seen := map[string]bool{}
+		for _, category := range categories[val.Value] {
+			if !seen[category] {
+				seen[category] = true
+				ret = fmt.Sprintf("%s%s: true, ", ret, strconv.Quote(category))
+			}
+		}
+		ret = ret + "},\n"
+	}
+	ret = ret + `}`
+	return
+}
+
+// CategorySet holds the values of an enum that belong to a category.
+type CategorySet struct {
+	Name   string
+	Ident  string
+	Values []EnumValue
+}
+
+// CategorySets returns the categories of the enum in the order they first appear, each with its values in declaration order.
+// When several names share a value, only the canonical one is listed.
+func CategorySets(e Enum) []CategorySet {
+	categories := map[interface{}]map[string]bool{}
+	var names []string
+	seen := map[string]bool{}
+	for _, val := range e.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		if categories[val.Value] == nil {
+			categories[val.Value] = map[string]bool{}
+		}
+		for _, category := range val.Categories {
+			categories[val.Value][category] = true
+			if !seen[category] {
+				seen[category] = true
+				names = append(names, category)
+			}
+		}
+	}
+	var ret []CategorySet
+	for _, name := range names {
+		set := CategorySet{
+			Name:  name,
+			Ident: snakeToCamelCase(sanitizeValue(e.Name+"_"+name, "", nil)),
+		}
+		for _, val := range Canonicals(e) {
+			if categories[val.Value][name] {
+				set.Values = append(set.Values, val)
+			}
+		}
+		ret = append(ret, set)
+	}
+	return ret
+}
+
+// Flagify returns the enum values holding a single bit, in declaration order.
+// When several names share a value, only the canonical one is listed.
+func Flagify(e Enum) []EnumValue {
+	var ret []EnumValue
+	for _, val := range Canonicals(e) {
+		switch v := val.Value.(type) {
+		case int64:
+			if v > 0 && v&(v-1) == 0 {
+				ret = append(ret, val)
+			}
+		case uint64:
+			if v > 0 && v&(v-1) == 0 {
+				ret = append(ret, val)
+			}
+		}
+	}
+	return ret
+}
+
+// SystemAliasify returns a map of each system to the lookup of its aliases, sorted by system and alias
+func SystemAliasify(e Enum) (ret string, err error) {
+	aliases := map[string]map[string]string{}
+	for _, val := range e.Values {
+		for system, names := range val.SystemAliases {
+			if aliases[system] == nil {
+				aliases[system] = map[string]string{}
+			}
+			for _, name := range names {
+				aliases[system][name] = val.PrefixedName
+			}
+		}
+	}
+	systems := make([]string, 0, len(aliases))
+	for system := range aliases {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+
+	ret = fmt.Sprintf("map[string]map[string]%s{\n", e.Name)
+	for _, system := range systems {
+		names := make([]string, 0, len(aliases[system]))
+		for name := range aliases[system] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		ret = fmt.Sprintf("%s%s: {\n", ret, strconv.Quote(system))
+		for _, name := range names {
+			ret = fmt.Sprintf("%s%s: %s,\n", ret, strconv.Quote(name), aliases[system][name])
+		}
+		ret = ret + "},\n"
+	}
+	ret = ret + `}`
+	return
+}
+
+// ShortCode pairs an enum value with its short code.
+// Canonical is set for the name that is used when encoding a value shared by several names.
+type ShortCode struct {
+	Value     EnumValue
+	Code      string
+	Canonical bool
+}
+
+// Transitify returns a map of every value of the enum that can transition to other values, to those values in declaration order.
+// When several names share a value, their transitions are merged and listed by their canonical names.
+func Transitify(e Enum) (ret string, err error) {
+	byName := map[string]interface{}{}
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			byName[val.RawName] = val.Value
+		}
+	}
+	canonicals := map[interface{}]string{}
+	for _, val := range Canonicals(e) {
+		canonicals[val.Value] = val.PrefixedName
+	}
+
+	next := map[interface{}][]string{}
+	seen := map[interface{}]map[string]bool{}
+	for _, val := range e.Values {
+		for _, name := range val.Next {
+			target := canonicals[byName[name]]
+			if seen[val.Value] == nil {
+				seen[val.Value] = map[string]bool{}
+			}
+			if !seen[val.Value][target] {
+				seen[val.Value][target] = true
+				next[val.Value] = append(next[val.Value], target)
+			}
+		}
+	}
+
+	ret = fmt.Sprintf("map[%s][]%s{\n", e.Name, e.Name)
+	for _, val := range Canonicals(e) {
+		if targets, ok := next[val.Value]; ok {
+			ret = fmt.Sprintf("%s%s: {%s},\n", ret, val.PrefixedName, strings.Join(targets, ", "))
+		}
+	}
+	ret = ret + `}`
+	return
+}
+
+// unsignedTypes are the underlying types of an enum holding unsigned values, any other type is signed.
+var unsignedTypes = map[string]bool{
+	"uint":    true,
+	"uint8":   true,
+	"uint16":  true,
+	"uint32":  true,
+	"uint64":  true,
+	"uintptr": true,
+	"byte":    true,
+}
+
+// IsUnsigned reports whether the underlying type of an enum holds unsigned values.
+func IsUnsigned(enumType string) bool {
+	return unsignedTypes[enumType]
+}
+
+// BinaryBits returns the size in bits of the integer type of an enum, as used by its binary encoding.
+// Types without an explicit size, like int and uint, are encoded as 64 bits regardless of the platform.
+func BinaryBits(enumType string) int {
+	switch enumType {
+	case "byte":
+		return 8
+	case "rune":
+		return 32
+	}
+	for _, bits := range []int{8, 16, 32} {
+		if strings.HasSuffix(enumType, strconv.Itoa(bits)) {
+			return bits
+		}
+	}
+	return 64
+}
+
+// ShortCodes returns the enum values without skipped values along with their short code, which is the unpadded URL-safe
+// base64 encoding of the big endian bytes of their declaration order index
+func ShortCodes(e Enum) []ShortCode {
+	canonical := map[string]bool{}
+	for _, val := range Canonicals(e) {
+		canonical[val.PrefixedName] = true
+	}
+	var ret []ShortCode
+	index := uint64(0)
+	for _, val := range e.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, index)
+		buf = bytes.TrimLeft(buf, "\x00")
+		if len(buf) == 0 {
+			buf = []byte{0}
+		}
+		ret = append(ret, ShortCode{Value: val, Code: base64.RawURLEncoding.EncodeToString(buf), Canonical: canonical[val.PrefixedName]})
+		index++
+	}
+	return ret
+}
+
+// fuzzyReplacer drops the separators ignored by the fuzzy parse.
+var fuzzyReplacer = strings.NewReplacer(" ", "", "-", "", "_", "")
+
+// FuzzyName normalizes a name for the fuzzy parse, dropping separators and lowercasing it.
+// It has to match the normalization the generated fuzzy parse does at runtime.
+func FuzzyName(name string) string {
+	return strings.ToLower(fuzzyReplacer.Replace(name))
+}
+
+// Fuzzify returns a map of the normalized name of every enum value to the value, sorted by normalized name
+func Fuzzify(e Enum) (ret string, err error) {
+	values := map[string]string{}
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			values[FuzzyName(val.RawName)] = val.PrefixedName
+		}
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret = fmt.Sprintf("map[string]%s{\n", e.Name)
+	for _, name := range names {
+		ret = fmt.Sprintf("%s%s: %s,\n", ret, strconv.Quote(name), values[name])
+	}
+	ret = ret + `}`
+	return
+}
+
+// Lowerify returns a map of the lower cased name of every enum value to the value, sorted by name.
+// When names only differ in case, the first one declared wins.
+func Lowerify(e Enum) (ret string, err error) {
+	values := map[string]string{}
+	for _, val := range e.Values {
+		name := strings.ToLower(val.RawName)
+		if _, ok := values[name]; val.Name != skipHolder && !ok {
+			values[name] = val.PrefixedName
+		}
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret = fmt.Sprintf("map[string]%s{\n", e.Name)
+	for _, name := range names {
+		ret = fmt.Sprintf("%s%s: %s,\n", ret, strconv.Quote(name), values[name])
+	}
+	ret = ret + `}`
+	return
+}
+
+// Mapify returns a map that is all of the indexes for a string value lookup.
+// When several names share a value, only the canonical one is used.
 func Mapify(e Enum) (ret string, err error) {
 	strName := fmt.Sprintf(`_%sName`, e.Name)
 	ret = fmt.Sprintf("map[%s]string{\n", e.Name)
+	names := map[interface{}]string{}
+	for _, val := range Canonicals(e) {
+		names[val.Value] = val.PrefixedName
+	}
 	index := 0
 	for _, val := range e.Values {
 		if val.Name != skipHolder {
-			nextIndex := index + len(val.Name)
-			ret = fmt.Sprintf("%s%s: %s[%d:%d],\n", ret, val.PrefixedName, strName, index, nextIndex)
+			nextIndex := index + len(val.RawName)
+			if names[val.Value] == val.PrefixedName {
+				ret = fmt.Sprintf("%s%s: %s[%d:%d],\n", ret, val.PrefixedName, strName, index, nextIndex)
+			}
 			index = nextIndex
 		}
 	}
@@ -43,7 +372,7 @@ func Unmapify(e Enum, lowercase bool) (ret string, err error) {
 	index := 0
 	for _, val := range e.Values {
 		if val.Name != skipHolder {
-			nextIndex := index + len(val.Name)
+			nextIndex := index + len(val.RawName)
 			ret = fmt.Sprintf("%s%s[%d:%d]: %s,\n", ret, strName, index, nextIndex, val.PrefixedName)
 			if lowercase {
 				ret = fmt.Sprintf("%sstrings.ToLower(%s[%d:%d]): %s,\n", ret, strName, index, nextIndex, val.PrefixedName)
@@ -62,7 +391,7 @@ func Namify(e Enum) (ret string, err error) {
 	index := 0
 	for _, val := range e.Values {
 		if val.Name != skipHolder {
-			nextIndex := index + len(val.Name)
+			nextIndex := index + len(val.RawName)
 			ret = fmt.Sprintf("%s%s[%d:%d],\n", ret, strName, index, nextIndex)
 			index = nextIndex
 		}
@@ -71,8 +400,197 @@ func Namify(e Enum) (ret string, err error) {
 	return
 }
 
+// Ordinalify returns a map of each enum value to its declaration order index, ignoring skipped values
+func Ordinalify(e Enum) (ret string, err error) {
+	ret = fmt.Sprintf("map[%s]int{\n", e.Name)
+	index := 0
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			ret = fmt.Sprintf("%s%s: %d,\n", ret, val.PrefixedName, index)
+			index++
+		}
+	}
+	ret = ret + `}`
+	return
+}
+
+// Sortify returns an array of name and value pairs sorted by name, for a binary search string value lookup
+func Sortify(e Enum, forceLower, lowercase bool) (ret string, err error) {
+	values := map[string]string{}
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			name := val.RawName
+			if forceLower {
+				name = strings.ToLower(name)
+			}
+			values[name] = val.PrefixedName
+			if lowercase {
+				values[strings.ToLower(name)] = val.PrefixedName
+			}
+		}
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ret = fmt.Sprintf("[...]struct {\nname string\nvalue %s\n}{\n", e.Name)
+	for _, name := range names {
+		ret = fmt.Sprintf("%s{%s, %s},\n", ret, strconv.Quote(name), values[name])
+	}
+	ret = ret + `}`
+	return
+}
+
+// Weightify returns a slice of the running total of the enum value weights, ignoring skipped values
+func Weightify(e Enum) (ret string, err error) {
+	ret = "[]int{\n"
+	total := 0
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			total += val.Weight
+			ret = fmt.Sprintf("%s%d,\n", ret, total)
+		}
+	}
+	ret = ret + "}"
+	return
+}
+
+// Labelify returns a map of each enum value to its snake_case metric label
+func Labelify(e Enum) (ret string, err error) {
+	ret = fmt.Sprintf("map[%s]string{\n", e.Name)
+	for _, val := range Canonicals(e) {
+		ret = fmt.Sprintf("%s%s: %s,\n", ret, val.PrefixedName, strconv.Quote(toSnakeCase(val.RawName)))
+	}
+	ret = ret + `}`
+	return
+}
+
+// Description returns the comment of an enum value, leaving out the directives it holds
+func Description(val EnumValue) string {
+	return descriptionFromComment(val.Comment)
+}
+
+// Describify returns a map of each enum value with a comment to that comment, leaving out the directives it holds
+func Describify(e Enum) (ret string, err error) {
+	ret = fmt.Sprintf("map[%s]string{\n", e.Name)
+	for _, val := range Canonicals(e) {
+		if desc := Description(val); desc != "" {
+			ret = fmt.Sprintf("%s%s: %s,\n", ret, val.PrefixedName, strconv.Quote(desc))
+		}
+	}
+	ret = ret + `}`
+	return
+}
+
+// Unlabelify returns a map of every snake_case metric label to its enum value, the first name declared wins when labels collide
+func Unlabelify(e Enum) (ret string, err error) {
+	ret = fmt.Sprintf("map[string]%s{\n", e.Name)
+	seen := map[string]bool{}
+	for _, val := range e.Values {
+		label := toSnakeCase(val.RawName)
+		if val.Name != skipHolder && !seen[label] {
+			seen[label] = true
+			ret = fmt.Sprintf("%s%s: %s,\n", ret, strconv.Quote(label), val.PrefixedName)
+		}
+	}
+	ret = ret + `}`
+	return
+}
+
+// MaxNameLen returns the length in bytes of the longest string returned by the String method of the enum
+func MaxNameLen(e Enum, forceLower bool) int {
+	max := 0
+	for _, val := range Canonicals(e) {
+		name := val.RawName
+		if forceLower {
+			name = strings.ToLower(name)
+		}
+		if len(name) > max {
+			max = len(name)
+		}
+	}
+	return max
+}
+
+// MaxValue returns the largest underlying value of the enum, skipped values excluded
+func MaxValue(e Enum) string {
+	values := Canonicals(e)
+	if len(values) == 0 {
+		return "0"
+	}
+	highest := values[0].Value
+	for _, val := range values[1:] {
+		if lessValue(highest, val.Value) {
+			highest = val.Value
+		}
+	}
+	return fmt.Sprint(highest)
+}
+
+// Patternify returns a quoted regular expression matching exactly the names of the enum values
+func Patternify(e Enum, forceLower bool) (ret string, err error) {
+	var names []string
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			name := val.RawName
+			if forceLower {
+				name = strings.ToLower(name)
+			}
+			names = append(names, regexp.QuoteMeta(name))
+		}
+	}
+	return strconv.Quote(`^(` + strings.Join(names, `|`) + `)$`), nil
+}
+
+// Validify returns a range check of x against the lowest and highest enum values when the values are contiguous,
+// or an empty string when they are not and a lookup is needed
+func Validify(e Enum) (ret string, err error) {
+	values := Canonicals(e)
+	if len(values) == 0 {
+		return "", nil
+	}
+	lowest, highest := values[0], values[0]
+	for _, val := range values[1:] {
+		if lessValue(val.Value, lowest.Value) {
+			lowest = val
+		}
+		if lessValue(highest.Value, val.Value) {
+			highest = val
+		}
+	}
+	var span uint64
+	switch low := lowest.Value.(type) {
+	case int64:
+		span = uint64(highest.Value.(int64) - low)
+	case uint64:
+		span = highest.Value.(uint64) - low
+	default:
+		return "", nil
+	}
+	if span != uint64(len(values)-1) {
+		return "", nil
+	}
+	if lowest.Value == uint64(0) {
+		return fmt.Sprintf("x <= %s", highest.PrefixedName), nil
+	}
+	return fmt.Sprintf("x >= %s && x <= %s", lowest.PrefixedName, highest.PrefixedName), nil
+}
+
+// lessValue reports whether the enum value a is lower than b
+func lessValue(a, b interface{}) bool {
+	switch a := a.(type) {
+	case int64:
+		return a < b.(int64)
+	case uint64:
+		return a < b.(uint64)
+	}
+	return false
+}
+
 func Offset(index int, enumType string, val EnumValue) (strResult string) {
-	if strings.HasPrefix(enumType, "u") {
+	if IsUnsigned(enumType) {
 		// Unsigned
 		return strconv.FormatUint(val.Value.(uint64)-uint64(index), 10)
 	} else {
@@ -0,0 +1,44 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/types"
+	"path/filepath"
+	"strings"
+)
+
+// checkTypes type checks the generated code together with the file the enums were declared in.
+// The rest of the package is not part of the check, so only the errors found in the generated code are reported.
+func (g *Generator) checkTypes(f *ast.File, generated []byte) error {
+	return g.checkGeneratedTypes(f.Name.Name, g.fileSet.Position(f.Pos()).Filename, generated, f)
+}
+
+// checkGeneratedTypes type checks the code generated from inputFile, together with the given go files of the package.
+func (g *Generator) checkGeneratedTypes(pkg, inputFile string, generated []byte, files ...*ast.File) error {
+	outputFile := strings.TrimSuffix(inputFile, filepath.Ext(inputFile)) + "_enum.go"
+
+	genFile, err := parser.ParseFile(g.fileSet, outputFile, generated, 0)
+	if err != nil {
+		return fmt.Errorf("generate: error parsing generated code: %s", err)
+	}
+
+	var errs []string
+	conf := types.Config{
+		Importer: importer.ForCompiler(g.fileSet, "source", nil),
+		Error: func(err error) {
+			if terr, ok := err.(types.Error); ok && terr.Fset.Position(terr.Pos).Filename == outputFile {
+				errs = append(errs, terr.Error())
+			}
+		},
+	}
+	// The errors are collected by conf.Error, the returned one is only the first of them.
+	_, _ = conf.Check(pkg, g.fileSet, append(files, genFile), nil)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("generate: generated code does not type check:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+//go:build !go1.18
+// +build !go1.18
+
+package generator
+
+import (
+	"go/ast"
+)
+
+// hasTypeParams checks whether the type spec declares a generic type, which is impossible before go 1.18.
+func hasTypeParams(ts *ast.TypeSpec) bool {
+	return false
+}
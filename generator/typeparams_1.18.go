@@ -0,0 +1,13 @@
+//go:build go1.18
+// +build go1.18
+
+package generator
+
+import (
+	"go/ast"
+)
+
+// hasTypeParams checks whether the type spec declares a generic type.
+func hasTypeParams(ts *ast.TypeSpec) bool {
+	return ts.TypeParams != nil && len(ts.TypeParams.List) > 0
+}
@@ -0,0 +1,10 @@
+// Package goenum holds the interface implemented by the enums generated with the marker interface option,
+// so that frameworks can detect them with a type assertion instead of reflection.
+package goenum
+
+// Enum is implemented by every enum generated with the marker interface option.
+type Enum interface {
+	String() string
+	// GoEnum only marks the type as a generated enum, it does nothing.
+	GoEnum()
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -21,23 +22,94 @@ var (
 )
 
 type rootT struct {
-	FileNames         cli.StringSlice
-	NoPrefix          bool
-	Lowercase         bool
-	NoCase            bool
-	Marshal           bool
-	SQL               bool
-	Flag              bool
-	Prefix            string
-	Names             bool
-	LeaveSnakeCase    bool
-	SQLNullStr        bool
-	SQLNullInt        bool
-	Ptr               bool
-	TemplateFileNames cli.StringSlice
-	Aliases           cli.StringSlice
-	MustParse         bool
-	ForceLower        bool
+	FileNames          cli.StringSlice
+	NoPrefix           bool
+	Lowercase          bool
+	NoCase             bool
+	Marshal            bool
+	SQL                bool
+	Flag               bool
+	Prefix             string
+	Names              bool
+	LeaveSnakeCase     bool
+	SQLNullStr         bool
+	SQLNullInt         bool
+	Ptr                bool
+	TemplateFileNames  cli.StringSlice
+	TemplateDir        string
+	Aliases            cli.StringSlice
+	Methods            cli.StringSlice
+	HTTPStatus         bool
+	HTTPStatusDefault  int
+	StringTemplate     string
+	TypeCheck          bool
+	PreserveCustom     bool
+	MustParse          bool
+	ForceLower         bool
+	ProtoInterop       bool
+	StrictNames        bool
+	Ordinal            bool
+	TextAppender       bool
+	NumericPrefix      string
+	Mapstructure       bool
+	Complete           bool
+	Expvar             bool
+	CSVHelpers         bool
+	ZeroHelpers        bool
+	PrefixedStrings    bool
+	EmptyAs            string
+	SortedParse        bool
+	EntCompat          bool
+	QueryParam         bool
+	Weights            bool
+	Translatable       bool
+	RawParse           bool
+	Sortable           bool
+	HexColor           bool
+	NumericPassthrough bool
+	Contiguous         bool
+	ContiguousNoSkip   bool
+	SQLDDL             bool
+	GoStringer         bool
+	VerboseErrors      bool
+	Seq                bool
+	MetricLabels       bool
+	DeprecationHook    bool
+	XMLMarshal         bool
+	CSVResourceHeader  bool
+	Categories         bool
+	LazyReverseMap     bool
+	MarkerInterface    bool
+	OrdinalConsts      bool
+	Definitions        bool
+	YAML               bool
+	MaxLen             bool
+	Pattern            bool
+	TOML               bool
+	BSON               bool
+	JSONZeroRepr       string
+	SQLDual            string
+	ByteCodec          bool
+	ValidatedWrapper   bool
+	Values             bool
+	Pgx                bool
+	Validate           bool
+	Bitflag            bool
+	SystemAliases      bool
+	Walk               bool
+	Descriptions       bool
+	MaxValue           bool
+	TypedMap           bool
+	ShortCode          bool
+	Lenient            bool
+	FuzzyParse         bool
+	Binary             bool
+	Transitions        bool
+	GQLGen             bool
+	ParseOrDefault     bool
+	Navigation         bool
+	NavigationClamp    bool
+	SourceOrder        bool
 }
 
 func main() {
@@ -127,6 +199,11 @@ func main() {
 				Usage:       "Additional template file(s) to generate enums.  Use more than one flag for more files. Templates will be executed in alphabetical order.",
 				Destination: &argv.TemplateFileNames,
 			},
+			&cli.StringFlag{
+				Name:        "templatedir",
+				Usage:       "The directory that relative template file paths are resolved against.  Defaults to the directory of each input file.",
+				Destination: &argv.TemplateDir,
+			},
 			&cli.StringSliceFlag{
 				Name:        "alias",
 				Aliases:     []string{"a"},
@@ -143,9 +220,361 @@ func main() {
 				Usage:       "Forces a camel cased comment to generate lowercased names.",
 				Destination: &argv.ForceLower,
 			},
+			&cli.BoolFlag{
+				Name:        "proto",
+				Usage:       "Adds conversions to and from the protobuf enum named in the PROTO(pkg.Type) comment directive.",
+				Destination: &argv.ProtoInterop,
+			},
+			&cli.BoolFlag{
+				Name:        "strictnames",
+				Usage:       "Fails generation when an enum value name needs sanitizing to become a valid identifier.",
+				Destination: &argv.StrictNames,
+			},
+			&cli.BoolFlag{
+				Name:        "ordinal",
+				Usage:       "Adds helpers to convert between enum values and their declaration order index.",
+				Destination: &argv.Ordinal,
+			},
+			&cli.BoolFlag{
+				Name:        "textappender",
+				Usage:       "Adds an AppendText method for writing the enum string into an existing buffer.",
+				Destination: &argv.TextAppender,
+			},
+			&cli.StringFlag{
+				Name:        "numericprefix",
+				Usage:       "Replaces the 'X' prefix added to enum value names that do not start with a letter.",
+				Destination: &argv.NumericPrefix,
+			},
+			&cli.BoolFlag{
+				Name:        "mapstructure",
+				Usage:       "Adds a mapstructure compatible decode hook for decoding strings into the enum.",
+				Destination: &argv.Mapstructure,
+			},
+			&cli.BoolFlag{
+				Name:        "complete",
+				Usage:       "Adds a Complete function returning the values whose names start with a prefix (case insensitive).",
+				Destination: &argv.Complete,
+			},
+			&cli.BoolFlag{
+				Name:        "expvar",
+				Usage:       "Adds a {{ENUM}}Var type implementing expvar.Var.",
+				Destination: &argv.Expvar,
+			},
+			&cli.BoolFlag{
+				Name:        "csv",
+				Usage:       "Adds CSVString and Parse{{ENUM}}CSV helpers for CSV fields.",
+				Destination: &argv.CSVHelpers,
+			},
+			&cli.BoolFlag{
+				Name:        "zero",
+				Usage:       "Adds a {{ENUM}}Zero function and an IsZero method.",
+				Destination: &argv.ZeroHelpers,
+			},
+			&cli.BoolFlag{
+				Name:        "prefixedstrings",
+				Usage:       "Uses the prefixed constant name as the string value of the enum (ignored with noprefix).",
+				Destination: &argv.PrefixedStrings,
+			},
+			&cli.StringFlag{
+				Name:        "emptyas",
+				Usage:       "Makes Parse return the named value for an empty string rather than an error. Fails when none of the enums has a value of that name.",
+				Destination: &argv.EmptyAs,
+			},
+			&cli.BoolFlag{
+				Name:        "sortedparse",
+				Usage:       "Parses with a binary search over a sorted array of names instead of a map lookup, avoiding the package level map.",
+				Destination: &argv.SortedParse,
+			},
+			&cli.BoolFlag{
+				Name:        "ent",
+				Usage:       "Adds a Values() []string method for ent's field.EnumValues interface, and a typed {{ENUM}}Values function.",
+				Destination: &argv.EntCompat,
+			},
+			&cli.BoolFlag{
+				Name:        "queryparam",
+				Usage:       "Adds a QueryParam method returning the wire form of the enum for url.Values, and a Parse{{ENUM}}QueryParam function.",
+				Destination: &argv.QueryParam,
+			},
+			&cli.BoolFlag{
+				Name:        "weights",
+				Usage:       "Adds a Weight method and a {{ENUM}}WeightedRandom selector, using the weight=N comment of each value (default 1).",
+				Destination: &argv.Weights,
+			},
+			&cli.BoolFlag{
+				Name:        "translatable",
+				Usage:       "Adds a StringWith method that returns the name from a provided translations map, falling back to String.",
+				Destination: &argv.Translatable,
+			},
+			&cli.BoolFlag{
+				Name:        "rawparse",
+				Usage:       "Adds Parse{{ENUM}}Token for tokenizers, and a Parse{{ENUM}}Raw exact lookup without any normalization.",
+				Destination: &argv.RawParse,
+			},
+			&cli.BoolFlag{
+				Name:        "sortable",
+				Usage:       "Adds a {{ENUM}}Slice type implementing sort.Interface, ordering by the underlying value.",
+				Destination: &argv.Sortable,
+			},
+			&cli.BoolFlag{
+				Name:        "hexcolor",
+				Usage:       "Adds a HexColor method, using the hex=#rrggbb comment of each value (empty when unset).",
+				Destination: &argv.HexColor,
+			},
+			&cli.BoolFlag{
+				Name:        "numericpassthrough",
+				Usage:       "Adds JSON marshalling that writes undefined values as their integer, and reads integers back as is.",
+				Destination: &argv.NumericPassthrough,
+			},
+			&cli.BoolFlag{
+				Name:        "sqlddl",
+				Usage:       "Adds {{ENUM}}SQLCheck and {{ENUM}}PostgresEnumDDL functions returning DDL snippets for the enum values.",
+				Destination: &argv.SQLDDL,
+			},
+			&cli.BoolFlag{
+				Name:        "gostringer",
+				Usage:       "Adds a GoString method returning the constant name of the value, for readable %#v output.",
+				Destination: &argv.GoStringer,
+			},
+			&cli.BoolFlag{
+				Name:        "verboseerrors",
+				Usage:       "Makes parse errors quote the input, truncated when long, and list the valid names of the enum.",
+				Destination: &argv.VerboseErrors,
+			},
+			&cli.BoolFlag{
+				Name:        "seq",
+				Usage:       "Adds {{ENUM}}Seq and {{ENUM}}Seq2 functions returning iterators over the values (requires go 1.23).",
+				Destination: &argv.Seq,
+			},
+			&cli.BoolFlag{
+				Name:        "contiguous",
+				Usage:       "Fails generation when the enum values are not a gapless sequence from 0, where skipped values fill their spot.",
+				Destination: &argv.Contiguous,
+			},
+			&cli.BoolFlag{
+				Name:        "contiguousnoskip",
+				Usage:       "Like contiguous, but skipped values count as gaps.",
+				Destination: &argv.ContiguousNoSkip,
+			},
+			&cli.BoolFlag{
+				Name:        "metriclabels",
+				Usage:       "Adds a LabelValue method returning a lowercase snake_case form for metric labels, and Parse{{ENUM}}LabelValue.",
+				Destination: &argv.MetricLabels,
+			},
+			&cli.BoolFlag{
+				Name:        "deprecationhook",
+				Usage:       "Adds a {{ENUM}}DeprecationHook variable that Parse calls with values whose comment starts with 'Deprecated:'.",
+				Destination: &argv.DeprecationHook,
+			},
+			&cli.BoolFlag{
+				Name:        "xml",
+				Usage:       "Adds xml marshalling methods for elements and attributes, naming the element after the xmlName= directive when present.",
+				Destination: &argv.XMLMarshal,
+			},
+			&cli.BoolFlag{
+				Name:        "csvresourceheader",
+				Usage:       "Skips the header row of the CSV files read by ENUM(@csv:file.csv) declarations.",
+				Destination: &argv.CSVResourceHeader,
+			},
+			&cli.BoolFlag{
+				Name:        "categories",
+				Usage:       "Adds an InCategory method, using the category=name[,name] comment of each value.",
+				Destination: &argv.Categories,
+			},
+			&cli.BoolFlag{
+				Name:        "lazyreversemap",
+				Usage:       "Builds the name to value map used by parsing on first use (with sync.Once), instead of at package initialization.",
+				Destination: &argv.LazyReverseMap,
+			},
+			&cli.BoolFlag{
+				Name:        "marker",
+				Usage:       "Adds a GoEnum marker method, so the enum implements the github.com/abice/go-enum/goenum.Enum interface.",
+				Destination: &argv.MarkerInterface,
+			},
+			&cli.BoolFlag{
+				Name:        "httpstatus",
+				Usage:       "Adds an HTTPStatus method, using the httpStatus=N comment of each value.",
+				Destination: &argv.HTTPStatus,
+			},
+			&cli.IntFlag{
+				Name:        "httpstatusdefault",
+				Usage:       "The status HTTPStatus returns for values without an httpStatus=N comment.",
+				Value:       http.StatusInternalServerError,
+				Destination: &argv.HTTPStatusDefault,
+			},
+			&cli.StringFlag{
+				Name:        "stringtemplate",
+				Usage:       "A text/template rendering the string form of each value at generation time, e.g. '{{.Name}} (#{{.Value}})'.",
+				Destination: &argv.StringTemplate,
+			},
+			&cli.BoolFlag{
+				Name:        "typecheck",
+				Usage:       "Type checks the generated code before writing it, to catch broken templates early. This slows generation down.",
+				Destination: &argv.TypeCheck,
+			},
+			&cli.BoolFlag{
+				Name:        "preservecustom",
+				Usage:       "Keeps the code following a '// go-enum:custom' line in the existing output file when regenerating it.",
+				Destination: &argv.PreserveCustom,
+			},
+			&cli.StringSliceFlag{
+				Name:        "methods",
+				Usage:       "Only keeps the generated functions and methods named, functions named without the enum name (e.g. Parse for ParseColor). Can be specified multiple times.",
+				Destination: &argv.Methods,
+			},
+			&cli.BoolFlag{
+				Name:        "ordinalconsts",
+				Usage:       "Adds a constant holding the declaration order index of each value, e.g. ColorRedOrdinal.",
+				Destination: &argv.OrdinalConsts,
+			},
+			&cli.BoolFlag{
+				Name:        "definitions",
+				Usage:       "Adds a {{ENUM}}Definitions function listing the name, value, description and deprecation of each value.",
+				Destination: &argv.Definitions,
+			},
+			&cli.BoolFlag{
+				Name:        "yaml",
+				Usage:       "Adds MarshalYAML and UnmarshalYAML methods, for yaml packages using the unmarshal function interface (like gopkg.in/yaml.v2).",
+				Destination: &argv.YAML,
+			},
+			&cli.BoolFlag{
+				Name:        "maxlen",
+				Usage:       "Adds a {{ENUM}}MaxNameLen constant holding the length of the longest string form of the enum values.",
+				Destination: &argv.MaxLen,
+			},
+			&cli.BoolFlag{
+				Name:        "pattern",
+				Usage:       "Adds a {{ENUM}}Pattern function returning a regular expression matching exactly the names of the enum.",
+				Destination: &argv.Pattern,
+			},
+			&cli.BoolFlag{
+				Name:        "toml",
+				Usage:       "Adds MarshalTOML and UnmarshalTOML methods, for toml packages like github.com/BurntSushi/toml.",
+				Destination: &argv.TOML,
+			},
+			&cli.BoolFlag{
+				Name:        "bson",
+				Usage:       "Adds MarshalBSONValue and UnmarshalBSONValue methods for the MongoDB driver, storing the enum as a string.",
+				Destination: &argv.BSON,
+			},
+			&cli.StringFlag{
+				Name:        "jsonzerorepr",
+				Usage:       "The json the zero value is marshalled as, and unmarshalled from, e.g. '\"\"' or 'null'.",
+				Destination: &argv.JSONZeroRepr,
+			},
+			&cli.StringFlag{
+				Name:        "sqldual",
+				Usage:       "Adds a Scan accepting both string columns holding the name and integer columns holding the value, and a Value writing the enum as the given representation, either 'string' or 'int'.",
+				Destination: &argv.SQLDual,
+			},
+			&cli.BoolFlag{
+				Name:        "bytecodec",
+				Usage:       "Adds {{ENUM}}.Byte, {{ENUM}}.ToByte and {{ENUM}}FromByte for a single byte encoding using the declaration order index. Fails for enums with more than 256 values.",
+				Destination: &argv.ByteCodec,
+			},
+			&cli.BoolFlag{
+				Name:        "validatedwrapper",
+				Usage:       "Adds a Valid{{ENUM}} wrapper type whose only constructor, NewValid{{ENUM}}, rejects values that are not defined.",
+				Destination: &argv.ValidatedWrapper,
+			},
+			&cli.BoolFlag{
+				Name:        "values",
+				Usage:       "Adds a {{ENUM}}Values function returning all the defined values in declaration order.",
+				Destination: &argv.Values,
+			},
+			&cli.BoolFlag{
+				Name:        "pgx",
+				Usage:       "Adds the pgx v5 TextValue and ScanText methods, storing the enum as its string form.",
+				Destination: &argv.Pgx,
+			},
+			&cli.BoolFlag{
+				Name:        "validate",
+				Usage:       "Adds an IsValid method checking whether the value is one of the defined values, without going through the string form.",
+				Destination: &argv.Validate,
+			},
+			&cli.BoolFlag{
+				Name:        "bitflag",
+				Usage:       "Generates bit flag enums: values default to powers of two, with Has, Set and Clear methods, and String and Parse handling | separated combinations.",
+				Destination: &argv.Bitflag,
+			},
+			&cli.BoolFlag{
+				Name:        "systemaliases",
+				Usage:       "Adds a Parse{{ENUM}}For(system, name) function, accepting the names declared for a system with alias:system=NAME comments on the values.",
+				Destination: &argv.SystemAliases,
+			},
+			&cli.BoolFlag{
+				Name:        "walk",
+				Usage:       "Adds a {{ENUM}}Walk function visiting every declared slot in order, including the _ placeholders as undefined slots.",
+				Destination: &argv.Walk,
+			},
+			&cli.BoolFlag{
+				Name:        "descriptions",
+				Usage:       "Adds a Description method returning the comment of the value, falling back to its string form.",
+				Destination: &argv.Descriptions,
+			},
+			&cli.BoolFlag{
+				Name:        "maxvalue",
+				Usage:       "Adds a {{ENUM}}MaxValue constant holding the largest underlying value of the enum.",
+				Destination: &argv.MaxValue,
+			},
+			&cli.BoolFlag{
+				Name:        "typedmap",
+				Usage:       "Adds a generic {{ENUM}}Map[V] map type keyed by the enum, with a New{{ENUM}}Map constructor adding an entry for every value (requires go 1.18).",
+				Destination: &argv.TypedMap,
+			},
+			&cli.BoolFlag{
+				Name:        "shortcode",
+				Usage:       "Adds a ShortCode method and {{ENUM}}FromShortCode function, using the URL-safe base64 encoding of the declaration order index.",
+				Destination: &argv.ShortCode,
+			},
+			&cli.BoolFlag{
+				Name:        "lenient",
+				Usage:       "Skips enums whose declaration fails to parse, reporting them on stderr, instead of failing the generation.",
+				Destination: &argv.Lenient,
+			},
+			&cli.BoolFlag{
+				Name:        "fuzzyparse",
+				Usage:       "Adds a Parse{{ENUM}}Fuzzy function, ignoring case, spaces, hyphens and underscores when matching names.",
+				Destination: &argv.FuzzyParse,
+			},
+			&cli.BoolFlag{
+				Name:        "binary",
+				Usage:       "Adds MarshalBinary and UnmarshalBinary methods, encoding the enum as its little-endian integer value, sized by the underlying type.",
+				Destination: &argv.Binary,
+			},
+			&cli.BoolFlag{
+				Name:        "transitions",
+				Usage:       "Adds an AllowedNext method listing the values each value can transition to, declared with the next=name[,name] value comments.",
+				Destination: &argv.Transitions,
+			},
+			&cli.BoolFlag{
+				Name:        "gqlgen",
+				Usage:       "Adds MarshalGQL and UnmarshalGQL methods, so the enum can be bound to a gqlgen enum.",
+				Destination: &argv.GQLGen,
+			},
+			&cli.BoolFlag{
+				Name:        "parseordefault",
+				Usage:       "Adds a Parse{{ENUM}}OrDefault function, returning the given default instead of an error when parsing fails.",
+				Destination: &argv.ParseOrDefault,
+			},
+			&cli.BoolFlag{
+				Name:        "navigation",
+				Usage:       "Adds Next and Prev methods stepping through the values in declaration order, wrapping around at the ends.",
+				Destination: &argv.Navigation,
+			},
+			&cli.BoolFlag{
+				Name:        "navigationclamp",
+				Usage:       "Adds the Next and Prev methods of --navigation, stopping at the ends instead of wrapping around.",
+				Destination: &argv.NavigationClamp,
+			},
+			&cli.BoolFlag{
+				Name:        "sourceorder",
+				Usage:       "Writes the enums in the order they are declared in the source, instead of sorted by name.",
+				Destination: &argv.SourceOrder,
+			},
 		},
 		Action: func(ctx *cli.Context) error {
-			if err := generator.ParseAliases(argv.Aliases.Value()); err != nil {
+			aliases, err := generator.ParseAliasEntries(argv.Aliases.Value())
+			if err != nil {
 				return err
 			}
 			for _, fileOption := range argv.FileNames.Value() {
@@ -155,6 +584,7 @@ func main() {
 				g.Revision = commit
 				g.BuildDate = date
 				g.BuiltBy = builtBy
+				g.WithAliases(aliases)
 
 				if argv.NoPrefix {
 					g.WithNoPrefix()
@@ -198,13 +628,214 @@ func main() {
 				if argv.ForceLower {
 					g.WithForceLower()
 				}
+				if argv.ProtoInterop {
+					g.WithProtoInterop()
+				}
+				if argv.StrictNames {
+					g.WithStrictNames()
+				}
+				if argv.Ordinal {
+					g.WithOrdinal()
+				}
+				if argv.TextAppender {
+					g.WithTextAppender()
+				}
+				if argv.NumericPrefix != "" {
+					g.WithNumericPrefix(argv.NumericPrefix)
+				}
+				if argv.Mapstructure {
+					g.WithMapstructure()
+				}
+				if argv.Complete {
+					g.WithComplete()
+				}
+				if argv.Expvar {
+					g.WithExpvar()
+				}
+				if argv.CSVHelpers {
+					g.WithCSVHelpers()
+				}
+				if argv.ZeroHelpers {
+					g.WithZeroHelpers()
+				}
+				if argv.PrefixedStrings {
+					g.WithPrefixedStrings()
+				}
+				if argv.EmptyAs != "" {
+					g.WithEmptyAs(argv.EmptyAs)
+				}
+				if argv.SortedParse {
+					g.WithSortedParse()
+				}
+				if argv.EntCompat {
+					g.WithEntCompat()
+				}
+				if argv.QueryParam {
+					g.WithQueryParam()
+				}
+				if argv.Weights {
+					g.WithWeights()
+				}
+				if argv.Translatable {
+					g.WithTranslatable()
+				}
+				if argv.RawParse {
+					g.WithRawParse()
+				}
+				if argv.Sortable {
+					g.WithSortable()
+				}
+				if argv.HexColor {
+					g.WithHexColor()
+				}
+				if argv.NumericPassthrough {
+					g.WithNumericPassthrough()
+				}
+				if argv.SQLDDL {
+					g.WithSQLDDL()
+				}
+				if argv.GoStringer {
+					g.WithGoStringer()
+				}
+				if argv.VerboseErrors {
+					g.WithVerboseErrors()
+				}
+				if argv.Seq {
+					g.WithSeq()
+				}
+				if argv.Contiguous || argv.ContiguousNoSkip {
+					g.WithRequireContiguous(!argv.ContiguousNoSkip)
+				}
+				if argv.MetricLabels {
+					g.WithMetricLabels()
+				}
+				if argv.DeprecationHook {
+					g.WithDeprecationHook()
+				}
+				if argv.XMLMarshal {
+					g.WithXML()
+				}
+				if argv.CSVResourceHeader {
+					g.WithCSVResourceHeader()
+				}
+				if argv.Categories {
+					g.WithCategories()
+				}
+				if argv.LazyReverseMap {
+					g.WithLazyReverseMap()
+				}
+				if argv.MarkerInterface {
+					g.WithMarkerInterface()
+				}
+				if argv.HTTPStatus {
+					g.WithHTTPStatus(argv.HTTPStatusDefault)
+				}
+				if argv.StringTemplate != "" {
+					g.WithStringTemplate(argv.StringTemplate)
+				}
+				if argv.TypeCheck {
+					g.WithTypeCheck()
+				}
+				if argv.PreserveCustom {
+					g.WithPreserveCustomRegion()
+				}
+				if methods := argv.Methods.Value(); len(methods) > 0 {
+					g.WithMethods(methods...)
+				}
+				if argv.OrdinalConsts {
+					g.WithOrdinalConstants()
+				}
+				if argv.Definitions {
+					g.WithDefinitions()
+				}
+				if argv.YAML {
+					g.WithYAML()
+				}
+				if argv.MaxLen {
+					g.WithMaxLen()
+				}
+				if argv.Pattern {
+					g.WithPattern()
+				}
+				if argv.TOML {
+					g.WithTOML()
+				}
+				if argv.BSON {
+					g.WithBSON()
+				}
+				if argv.JSONZeroRepr != "" {
+					g.WithJSONZeroRepr(argv.JSONZeroRepr)
+				}
+				if argv.SQLDual != "" {
+					g.WithSQLDual(argv.SQLDual)
+				}
+				if argv.ByteCodec {
+					g.WithByteCodec()
+				}
+				if argv.ValidatedWrapper {
+					g.WithValidatedWrapper()
+				}
+				if argv.Values {
+					g.WithValues()
+				}
+				if argv.Pgx {
+					g.WithPgx()
+				}
+				if argv.Validate {
+					g.WithValidate()
+				}
+				if argv.Bitflag {
+					g.WithBitflag()
+				}
+				if argv.SystemAliases {
+					g.WithSystemAliases()
+				}
+				if argv.Walk {
+					g.WithWalk()
+				}
+				if argv.Descriptions {
+					g.WithDescriptions()
+				}
+				if argv.MaxValue {
+					g.WithMaxValue()
+				}
+				if argv.TypedMap {
+					g.WithTypedMap()
+				}
+				if argv.ShortCode {
+					g.WithShortCode()
+				}
+				if argv.Lenient {
+					g.WithLenientParse()
+				}
+				if argv.FuzzyParse {
+					g.WithFuzzyParse()
+				}
+				if argv.Binary {
+					g.WithBinary()
+				}
+				if argv.Transitions {
+					g.WithTransitions()
+				}
+				if argv.GQLGen {
+					g.WithGQLGen()
+				}
+				if argv.ParseOrDefault {
+					g.WithParseOrDefault()
+				}
+				if argv.Navigation {
+					g.WithNavigation()
+				}
+				if argv.NavigationClamp {
+					g.WithNavigationClamp()
+				}
+				if argv.SourceOrder {
+					g.WithSourceOrder()
+				}
 				if templates := []string(argv.TemplateFileNames.Value()); len(templates) > 0 {
-					for _, t := range templates {
-						if fn, err := globFilenames(t); err != nil {
-							return err
-						} else {
-							g.WithTemplates(fn...)
-						}
+					g.WithTemplateDir(argv.TemplateDir)
+					if err := g.WithTemplates(templates...); err != nil {
+						return err
 					}
 				}
 
@@ -223,7 +854,11 @@ func main() {
 					outFilePath := fmt.Sprintf("%s_enum.go", strings.TrimSuffix(fileName, filepath.Ext(fileName)))
 
 					// Parse the file given in arguments
-					raw, err := g.GenerateFromFile(fileName)
+					generate := g.GenerateFromFile
+					if filepath.Ext(fileName) == ".proto" {
+						generate = g.GenerateFromProto
+					}
+					raw, err := generate(fileName)
 					if err != nil {
 						return fmt.Errorf("failed generating enums\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
 					}
@@ -234,6 +869,11 @@ func main() {
 						continue
 					}
 
+					raw, err = g.MergeCustomRegion(outFilePath, raw)
+					if err != nil {
+						return err
+					}
+
 					mode := int(0644)
 					err = ioutil.WriteFile(outFilePath, raw, os.FileMode(mode))
 					if err != nil {